@@ -0,0 +1,14 @@
+variable "backup_encryption_key" {
+  type      = string
+  sensitive = true
+}
+
+action "nd_backup" "example" {
+  config {
+    name           = "on_demand_backup"
+    description    = "On-demand backup"
+    type           = "config"
+    encryption_key = var.backup_encryption_key
+    timeout        = "2h"
+  }
+}
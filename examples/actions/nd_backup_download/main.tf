@@ -0,0 +1,8 @@
+action "nd_backup_download" "example" {
+  config {
+    backup_name = "daily_backup"
+    destination = "${path.module}/daily_backup.tgz"
+    overwrite   = true
+    timeout     = "2h"
+  }
+}
@@ -0,0 +1,28 @@
+action "nd_cluster_resync" "example" {
+  config {
+    fabric_name = "apic1"
+  }
+}
+
+action "nd_cluster_resync" "example_refresh" {
+  config {
+    fabric_name = "apic1"
+    operation   = "refresh"
+    timeout     = "10m"
+  }
+}
+
+resource "nd_multi_cluster_connectivity" "example" {
+  fabric_name = "apic1"
+  username    = "admin"
+  password    = "password"
+  hostname    = "198.18.133.101"
+  type        = "apic"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.nd_cluster_resync.example]
+    }
+  }
+}
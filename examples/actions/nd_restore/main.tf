@@ -0,0 +1,24 @@
+variable "backup_encryption_key" {
+  type      = string
+  sensitive = true
+}
+
+action "nd_restore" "example" {
+  config {
+    backup_name     = "on_demand_backup"
+    mode            = "merge"
+    encryption_key  = var.backup_encryption_key
+    confirm_restore = true
+    timeout         = "2h"
+  }
+}
+
+action "nd_restore" "example_file" {
+  config {
+    remote_location = "example_remote_location"
+    file            = "/backups/on_demand_backup.tgz"
+    mode            = "overwrite"
+    encryption_key  = var.backup_encryption_key
+    confirm_restore = true
+  }
+}
@@ -0,0 +1,17 @@
+action "nd_smtp_test_email" "example" {
+  config {
+    recipient = "noc@example.com"
+  }
+}
+
+resource "nd_smtp_config" "example" {
+  server       = "smtp.example.com"
+  from_address = "nd@example.com"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.nd_smtp_test_email.example]
+    }
+  }
+}
@@ -0,0 +1 @@
+data "nd_backups" "example" {}
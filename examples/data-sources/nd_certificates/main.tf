@@ -0,0 +1,5 @@
+data "nd_certificates" "example" {}
+
+output "certificate_expiry" {
+  value = { for certificate in data.nd_certificates.example.certificates : certificate.name => certificate.not_after }
+}
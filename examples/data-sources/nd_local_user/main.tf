@@ -0,0 +1,3 @@
+data "nd_local_user" "example" {
+  login_id = "example_user"
+}
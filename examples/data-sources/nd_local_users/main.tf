@@ -0,0 +1 @@
+data "nd_local_users" "example" {}
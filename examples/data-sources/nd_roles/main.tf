@@ -0,0 +1 @@
+data "nd_roles" "example" {}
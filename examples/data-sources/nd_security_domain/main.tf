@@ -0,0 +1,3 @@
+data "nd_security_domain" "example" {
+  name = "example_domain"
+}
@@ -1,2 +1,7 @@
 data "nd_version" "example" {
 }
+
+data "nd_version" "example_constraint" {
+  minimum_version    = "3.2.1e"
+  version_constraint = ">= 3.2, < 4.2"
+}
@@ -0,0 +1,3 @@
+output "inband_epg_dn" {
+  value = provider::nd::apic_inband_epg_dn("inband")
+}
@@ -0,0 +1,6 @@
+data "nd_version" "example" {
+}
+
+output "is_nd_4_1_or_later" {
+  value = provider::nd::compare_versions(data.nd_version.example.version, "4.1") >= 0
+}
@@ -0,0 +1,3 @@
+output "fabric_host" {
+  value = provider::nd::parse_fabric_url("https://apic.example.com:8443").host
+}
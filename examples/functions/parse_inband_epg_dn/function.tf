@@ -0,0 +1,3 @@
+output "inband_epg_name" {
+  value = provider::nd::parse_inband_epg_dn("uni/tn-mgmt/mgmtp-default/inb-inband")
+}
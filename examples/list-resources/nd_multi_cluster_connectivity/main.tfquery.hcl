@@ -0,0 +1,12 @@
+list "nd_multi_cluster_connectivity" "example" {
+  provider = nd
+}
+
+list "nd_multi_cluster_connectivity" "example_filtered" {
+  provider = nd
+  config {
+    type              = "apic"
+    fabric_name_regex = "^apic"
+    hostname_regex    = "example\\.com"
+  }
+}
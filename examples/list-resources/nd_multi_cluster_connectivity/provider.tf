@@ -0,0 +1,15 @@
+terraform {
+  required_version = ">= 1.14.0"
+  required_providers {
+    nd = {
+      source = "ciscodevnet/nd"
+    }
+  }
+}
+
+provider "nd" {
+  username = ""
+  password = ""
+  url      = ""
+  insecure = true
+}
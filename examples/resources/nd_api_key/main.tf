@@ -0,0 +1,7 @@
+resource "nd_api_key" "example" {
+  login_id    = "automation"
+  description = "Key of the automation pipeline"
+  rotation_triggers = {
+    rotated = "2026-01-01"
+  }
+}
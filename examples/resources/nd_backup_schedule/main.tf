@@ -0,0 +1,24 @@
+resource "nd_remote_location" "example" {
+  name     = "example_remote_location"
+  protocol = "nas"
+  host     = "192.168.10.151"
+  path     = "/exports/backups"
+}
+
+resource "nd_backup_schedule" "example" {
+  name                          = "example_backup_schedule"
+  description                   = "Example backup schedule"
+  type                          = "config"
+  frequency                     = "daily"
+  start_time                    = "2030-01-01T02:00:00Z"
+  retention                     = 7
+  remote_location               = nd_remote_location.example.name
+  enabled                       = true
+  encryption_passphrase         = var.encryption_passphrase
+  encryption_passphrase_version = 1
+}
+
+variable "encryption_passphrase" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,24 @@
+# Upload a certificate chain and its private key and bind the certificate to the web UI.
+resource "nd_certificate" "web_ui" {
+  name            = "web_ui"
+  certificate     = file("nd.example.com.chain.pem")
+  private_key     = file("nd.example.com.key")
+  secrets_version = 1
+  web_ui          = true
+}
+
+# Generate a certificate signing request on ND, the private key never leaves ND.
+resource "nd_certificate" "csr" {
+  name = "nd_csr"
+  csr = {
+    common_name               = "nd.example.com"
+    organization              = "Example"
+    country                   = "US"
+    subject_alternative_names = ["nd.example.com", "10.0.0.10"]
+    key_type                  = "rsa4096"
+  }
+}
+
+output "certificate_signing_request" {
+  value = nd_certificate.csr.certificate_signing_request
+}
@@ -0,0 +1,9 @@
+resource "nd_firmware_image" "example" {
+  source_path = "/images/nd-dk9.4.1.2a.iso"
+  checksum    = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+}
+
+resource "nd_cluster_upgrade" "example" {
+  image   = nd_firmware_image.example.name
+  timeout = "6h"
+}
@@ -0,0 +1,4 @@
+resource "nd_dns_config" "example" {
+  providers      = ["10.0.0.53", "10.0.1.53"]
+  search_domains = ["example.com"]
+}
@@ -0,0 +1,14 @@
+resource "nd_email_notification" "example" {
+  name        = "example_noc"
+  description = "Example email notification"
+  recipients  = ["noc@example.com", "oncall@example.com"]
+  categories  = ["cluster", "nodes", "services"]
+  severities  = ["critical", "major"]
+}
+
+resource "nd_email_notification" "example_security" {
+  name       = "example_security"
+  recipients = ["soc@example.com"]
+  categories = ["security"]
+  severities = ["critical", "major", "minor", "warning", "info"]
+}
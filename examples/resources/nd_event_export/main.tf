@@ -0,0 +1,36 @@
+resource "nd_syslog_destination" "example" {
+  name = "example_syslog"
+  host = "192.168.10.160"
+}
+
+resource "nd_event_export" "example_syslog" {
+  name               = "example_syslog_export"
+  type               = "syslog"
+  categories         = ["events", "audit_logs"]
+  severity           = "minor"
+  syslog_destination = nd_syslog_destination.example.name
+}
+
+resource "nd_event_export" "example_webhook" {
+  name            = "example_webhook_export"
+  description     = "Example webhook event export"
+  type            = "webhook"
+  categories      = ["audit_logs"]
+  url             = "https://collector.example.com/nd"
+  token           = var.token
+  secrets_version = 1
+}
+
+resource "nd_event_export" "example_kafka" {
+  name       = "example_kafka_export"
+  type       = "kafka"
+  categories = ["events"]
+  severity   = "critical"
+  brokers    = ["kafka1.example.com:9093", "kafka2.example.com:9093"]
+  topic      = "nd-events"
+}
+
+variable "token" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,11 @@
+resource "nd_external_service_pool" "management_ipv4" {
+  network_type = "management"
+  ip_family    = "ipv4"
+  ips          = ["192.168.10.200", "192.168.10.201"]
+}
+
+resource "nd_external_service_pool" "data_ipv4" {
+  network_type = "data"
+  ip_family    = "ipv4"
+  ips          = ["10.0.0.200", "10.0.0.201", "10.0.0.202"]
+}
@@ -0,0 +1,10 @@
+resource "nd_firmware_image" "example" {
+  source_path = "/images/nd-dk9.4.1.2a.iso"
+  checksum    = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+  timeout     = "2h"
+}
+
+resource "nd_firmware_image" "example_url" {
+  source_url = "https://images.example.com/nd/nd-dk9.4.1.2b.iso"
+  checksum   = "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"
+}
@@ -0,0 +1,15 @@
+resource "nd_local_user" "example" {
+  login_id         = "example_user"
+  first_name       = "Example"
+  last_name        = "User"
+  email            = "example_user@example.com"
+  password         = "C1sco12345!"
+  password_version = 1
+  account_status   = "active"
+  security_domains = [
+    {
+      name  = "all"
+      roles = ["observer", "fabric-admin"]
+    }
+  ]
+}
@@ -0,0 +1,63 @@
+resource "nd_login_domain" "ldap" {
+  name            = "example_ldap"
+  description     = "Example LDAP login domain"
+  realm           = "ldap"
+  secrets_version = 1
+  ldap = {
+    hosts         = ["10.0.0.1", "10.0.0.2"]
+    port          = 636
+    base_dn       = "ou=users,dc=example,dc=com"
+    bind_dn       = "cn=admin,dc=example,dc=com"
+    bind_password = "C1sco12345!"
+    filter        = "cn=$userid"
+    attribute     = "CiscoAVPair"
+    ssl           = true
+    ssl_verify    = true
+    timeout       = 30
+  }
+}
+
+resource "nd_login_domain" "radius" {
+  name            = "example_radius"
+  realm           = "radius"
+  secrets_version = 1
+  radius = {
+    servers = [
+      {
+        hostname = "10.0.0.3"
+        port     = 1812
+        key      = "C1sco12345!"
+      }
+    ]
+    authentication_protocol = "pap"
+    timeout                 = 5
+    retries                 = 1
+  }
+}
+
+resource "nd_login_domain" "tacacs" {
+  name            = "example_tacacs"
+  realm           = "tacacs"
+  secrets_version = 1
+  tacacs = {
+    servers = [
+      {
+        hostname = "10.0.0.4"
+        key      = "C1sco12345!"
+      }
+    ]
+    authentication_protocol = "chap"
+  }
+}
+
+resource "nd_login_domain" "oidc" {
+  name            = "example_oidc"
+  realm           = "oidc"
+  secrets_version = 1
+  oidc = {
+    issuer_url    = "https://idp.example.com"
+    client_id     = "nexus-dashboard"
+    client_secret = "C1sco12345!"
+    scopes        = ["openid", "profile", "email"]
+  }
+}
@@ -0,0 +1,27 @@
+resource "nd_ntp_servers" "example" {
+  servers = [
+    {
+      host      = "10.0.0.1"
+      key_id    = 1
+      preferred = true
+    },
+    {
+      host = "10.0.0.2"
+    }
+  ]
+  keys = [
+    {
+      id                  = 1
+      key                 = var.ntp_key
+      authentication_type = "sha1"
+      trusted             = true
+    }
+  ]
+  keys_version = 1
+  vrf          = "management"
+}
+
+variable "ntp_key" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,30 @@
+resource "nd_remote_location" "example" {
+  name                = "example_remote_location"
+  description         = "Example remote location"
+  protocol            = "sftp"
+  host                = "192.168.10.150"
+  port                = 22
+  path                = "/backups"
+  username            = "backup"
+  authentication_type = "ssh_key"
+  ssh_key             = var.ssh_key
+  encryption_key      = var.encryption_key
+  secrets_version     = 1
+}
+
+resource "nd_remote_location" "example_nas" {
+  name     = "example_nas_location"
+  protocol = "nas"
+  host     = "192.168.10.151"
+  path     = "/exports/backups"
+}
+
+variable "ssh_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "encryption_key" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,14 @@
+resource "nd_role" "example" {
+  name        = "automation"
+  description = "Least-privilege role of the automation accounts"
+  privileges = [
+    {
+      name   = "fabricManagement"
+      access = "write"
+    },
+    {
+      name   = "eventManagement"
+      access = "read"
+    }
+  ]
+}
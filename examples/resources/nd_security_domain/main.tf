@@ -0,0 +1,6 @@
+resource "nd_security_domain" "example" {
+  name        = "example_domain"
+  description = "Example security domain"
+  restricted  = true
+  fabrics     = ["nd1"]
+}
@@ -0,0 +1,13 @@
+resource "nd_service" "orchestrator" {
+  name    = "orchestrator"
+  version = "4.5.1"
+}
+
+resource "nd_service" "insights" {
+  name        = "insights"
+  version     = "6.5.2"
+  source_path = "/images/insights-6.5.2.nd"
+  enabled     = true
+  profile     = "large"
+  timeout     = "2h"
+}
@@ -0,0 +1,14 @@
+resource "nd_smtp_config" "example" {
+  server          = "smtp.example.com"
+  port            = 587
+  tls_mode        = "starttls"
+  username        = "nd"
+  password        = var.smtp_password
+  from_address    = "nd@example.com"
+  secrets_version = 1
+}
+
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+}
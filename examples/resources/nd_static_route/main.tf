@@ -0,0 +1,9 @@
+resource "nd_static_route" "management" {
+  network_type = "management"
+  prefix       = "10.100.0.0/16"
+}
+
+resource "nd_static_route" "data" {
+  network_type = "data"
+  prefix       = "2001:db8:100::/48"
+}
@@ -0,0 +1,16 @@
+resource "nd_syslog_destination" "example_minimum" {
+  name = "example_syslog"
+  host = "syslog.example.com"
+}
+
+resource "nd_syslog_destination" "example" {
+  name           = "example_syslog"
+  description    = "Example syslog destination"
+  host           = "syslog.example.com"
+  port           = 6514
+  protocol       = "tls"
+  facility       = "local3"
+  severity       = "informational"
+  ca_certificate = "-----BEGIN CERTIFICATE-----"
+  enabled        = true
+}
@@ -0,0 +1,23 @@
+resource "nd_trusted_ca" "private_pki" {
+  name        = "private_pki"
+  description = "Root and intermediate CA of the private PKI"
+  certificate = file("private-pki-ca-bundle.pem")
+  usages      = ["clusters", "remote_locations"]
+}
+
+# The certificate of the APIC cluster is signed by the private PKI, the CA is trusted before the cluster is onboarded.
+resource "nd_multi_cluster_connectivity" "apic" {
+  fabric_name               = "apic1"
+  username                  = "admin"
+  password                  = var.apic_password
+  hostname                  = "apic1.example.com"
+  type                      = "apic"
+  validate_peer_certificate = true
+
+  depends_on = [nd_trusted_ca.private_pki]
+}
+
+variable "apic_password" {
+  type      = string
+  sensitive = true
+}
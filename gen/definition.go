@@ -0,0 +1,518 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"gopkg.in/yaml.v3"
+)
+
+// Definition describes a single ND object from which a resource, a data source, examples, tests and documentation are generated.
+type Definition struct {
+	// Name is the name of the object without the provider prefix, ie "security_domain" for "nd_security_domain".
+	Name string `yaml:"name"`
+	// StructName is the Go name used for the generated types, ie "SecurityDomain".
+	StructName  string `yaml:"struct_name"`
+	Label       string `yaml:"label"`
+	Description string `yaml:"description"`
+	Subcategory string `yaml:"subcategory"`
+	// UiLocation is the navigation path in the ND GUI shown in the documentation.
+	UiLocation    string      `yaml:"ui_location"`
+	ApiReference  string      `yaml:"api_reference"`
+	Api           Api         `yaml:"api"`
+	Discriminator string      `yaml:"discriminator"`
+	Attributes    []Attribute `yaml:"attributes"`
+	// SkipDataSource disables the generation of the data source for objects that cannot be read by their identifier.
+	SkipDataSource bool `yaml:"skip_data_source"`
+}
+
+// Api describes the ND API endpoint of the object.
+type Api struct {
+	// Path is the collection path used for POST requests, the object path is "{path}/{id}".
+	// It is a shorthand for a single entry in Paths without version bounds.
+	Path string `yaml:"path"`
+	// Paths are the collection paths of the object for ranges of ND versions.
+	Paths []ApiPath `yaml:"paths"`
+	// IdAttribute is the attribute whose value identifies the object in the object path.
+	IdAttribute string `yaml:"id_attribute"`
+	// IdPointer is the JSON pointer of the identifier in the POST response, used when ND generates the identifier.
+	IdPointer string `yaml:"id_pointer"`
+	// UpdateMethod is the HTTP method used to update the object, defaults to "PUT".
+	UpdateMethod string `yaml:"update_method"`
+}
+
+// ApiPath is the collection path of the object for a range of ND versions.
+type ApiPath struct {
+	// MinimumVersion is the first ND version using the path, the range has no lower bound when it is not provided.
+	MinimumVersion string `yaml:"minimum_version"`
+	// MaximumVersion is the first ND version no longer using the path, the range has no upper bound when it is not provided.
+	MaximumVersion string `yaml:"maximum_version"`
+	Path           string `yaml:"path"`
+}
+
+// Attribute describes a single attribute of the object and its location in the ND payload.
+type Attribute struct {
+	Name        string `yaml:"name"`
+	Type        string `yaml:"type"`
+	ElementType string `yaml:"element_type"`
+	// JsonPointer is the RFC 6901 location of the value in both the request and the response payload.
+	JsonPointer     string      `yaml:"json_pointer"`
+	Description     string      `yaml:"description"`
+	Required        bool        `yaml:"required"`
+	Optional        bool        `yaml:"optional"`
+	Computed        bool        `yaml:"computed"`
+	Sensitive       bool        `yaml:"sensitive"`
+	WriteOnly       bool        `yaml:"write_only"`
+	NotReturned     bool        `yaml:"not_returned"`
+	RequiresReplace bool        `yaml:"requires_replace"`
+	Default         interface{} `yaml:"default"`
+	Validators      Validators  `yaml:"validators"`
+	// AppliesTo lists the values of the discriminator attribute for which the attribute may be configured.
+	AppliesTo []string `yaml:"applies_to"`
+	// RequiredFor lists the values of the discriminator attribute for which the attribute must be configured.
+	RequiredFor []string `yaml:"required_for"`
+	// TestValue and TestUpdateValue are used in the generated acceptance tests.
+	TestValue       interface{} `yaml:"test_value"`
+	TestUpdateValue interface{} `yaml:"test_update_value"`
+	// ExampleValue is used in the generated examples and documentation.
+	ExampleValue interface{} `yaml:"example_value"`
+
+	// discriminator is the name of the discriminator attribute of the definition, set during validation.
+	discriminator string
+}
+
+// Validators describes the plan time validation of an attribute.
+type Validators struct {
+	OneOf         []string `yaml:"one_of"`
+	LengthBetween []int64  `yaml:"length_between"`
+	IntBetween    []int64  `yaml:"int_between"`
+	Regex         *Regex   `yaml:"regex"`
+}
+
+type Regex struct {
+	Pattern string `yaml:"pattern"`
+	Message string `yaml:"message"`
+}
+
+var definitionNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+var attributeTypes = map[string]bool{
+	"string":  true,
+	"bool":    true,
+	"int64":   true,
+	"float64": true,
+	"set":     true,
+	"list":    true,
+}
+
+func loadDefinitions(dir string) ([]*Definition, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	definitions := make([]*Definition, 0, len(files))
+	for _, file := range files {
+		definition, err := loadDefinition(file)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, nil
+}
+
+func loadDefinition(file string) (*Definition, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition Definition
+	decoder := yaml.NewDecoder(strings.NewReader(string(content)))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&definition); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	if err := definition.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return &definition, nil
+}
+
+func (d *Definition) validate() error {
+	if !definitionNameRegex.MatchString(d.Name) {
+		return fmt.Errorf("invalid name '%s'", d.Name)
+	}
+	if d.StructName == "" {
+		return fmt.Errorf("struct_name is required")
+	}
+	if d.Label == "" {
+		d.Label = strings.ReplaceAll(d.Name, "_", " ")
+	}
+	if (d.Api.Path == "") == (len(d.Api.Paths) == 0) {
+		return fmt.Errorf("one of api.path or api.paths is required")
+	}
+	if d.Api.Path != "" {
+		d.Api.Paths = []ApiPath{{Path: d.Api.Path}}
+	}
+	for _, apiPath := range d.Api.Paths {
+		if !strings.HasPrefix(apiPath.Path, "/") {
+			return fmt.Errorf("api path '%s' must start with '/'", apiPath.Path)
+		}
+		for _, version := range []string{apiPath.MinimumVersion, apiPath.MaximumVersion} {
+			if _, err := nd.ParseVersion(version); version != "" && err != nil {
+				return fmt.Errorf("api path '%s': %w", apiPath.Path, err)
+			}
+		}
+	}
+	if d.Api.UpdateMethod == "" {
+		d.Api.UpdateMethod = "PUT"
+	}
+	if d.Api.IdAttribute == "" && d.Api.IdPointer == "" {
+		return fmt.Errorf("one of api.id_attribute or api.id_pointer is required")
+	}
+	if d.Api.IdAttribute != "" && d.Attribute(d.Api.IdAttribute) == nil {
+		return fmt.Errorf("api.id_attribute '%s' is not a defined attribute", d.Api.IdAttribute)
+	}
+	if d.Discriminator != "" {
+		discriminator := d.Attribute(d.Discriminator)
+		if discriminator == nil || len(discriminator.Validators.OneOf) == 0 {
+			return fmt.Errorf("discriminator '%s' must be a defined attribute with one_of validator", d.Discriminator)
+		}
+	}
+
+	names := map[string]bool{"id": true}
+	for i := range d.Attributes {
+		attribute := &d.Attributes[i]
+		if names[attribute.Name] {
+			return fmt.Errorf("duplicate attribute '%s'", attribute.Name)
+		}
+		names[attribute.Name] = true
+
+		if !attributeTypes[attribute.Type] {
+			return fmt.Errorf("attribute '%s' has unsupported type '%s'", attribute.Name, attribute.Type)
+		}
+		if attribute.IsCollection() && attribute.ElementType != "string" {
+			return fmt.Errorf("attribute '%s' must have element_type 'string'", attribute.Name)
+		}
+		if !strings.HasPrefix(attribute.JsonPointer, "/") {
+			return fmt.Errorf("attribute '%s' must have a json_pointer starting with '/'", attribute.Name)
+		}
+		if len(attribute.AppliesTo) > 0 && d.Discriminator == "" {
+			return fmt.Errorf("attribute '%s' uses applies_to without a discriminator", attribute.Name)
+		}
+		if len(attribute.RequiredFor) > 0 && (d.Discriminator == "" || !attribute.Optional) {
+			return fmt.Errorf("attribute '%s' uses required_for without a discriminator or is not optional", attribute.Name)
+		}
+		for _, value := range attribute.RequiredFor {
+			if len(attribute.AppliesTo) > 0 && !contains(attribute.AppliesTo, value) {
+				return fmt.Errorf("attribute '%s' is required for '%s' but not applicable to it", attribute.Name, value)
+			}
+		}
+		attribute.discriminator = d.Discriminator
+		if attribute.Required == attribute.Optional && !attribute.Computed || attribute.Required && (attribute.Optional || attribute.Computed) {
+			return fmt.Errorf("attribute '%s' must be either required, optional or computed", attribute.Name)
+		}
+		if attribute.NotReturned && attribute.Type != "string" {
+			return fmt.Errorf("not returned attribute '%s' must be of type 'string'", attribute.Name)
+		}
+		if attribute.Default != nil && (attribute.IsCollection() || attribute.NotReturned) {
+			return fmt.Errorf("attribute '%s' cannot have a default", attribute.Name)
+		}
+		if attribute.Required && attribute.TestValue == nil {
+			return fmt.Errorf("required attribute '%s' must have a test_value", attribute.Name)
+		}
+		if attribute.WriteOnly && (attribute.Computed || attribute.Default != nil) {
+			return fmt.Errorf("write only attribute '%s' cannot be computed or have a default", attribute.Name)
+		}
+		if len(attribute.Validators.LengthBetween) != 0 && len(attribute.Validators.LengthBetween) != 2 {
+			return fmt.Errorf("attribute '%s' length_between requires a minimum and maximum", attribute.Name)
+		}
+		if len(attribute.Validators.IntBetween) != 0 && len(attribute.Validators.IntBetween) != 2 {
+			return fmt.Errorf("attribute '%s' int_between requires a minimum and maximum", attribute.Name)
+		}
+	}
+	return nil
+}
+
+// Attribute returns the attribute with the provided name or nil when it is not defined.
+func (d *Definition) Attribute(name string) *Attribute {
+	for i := range d.Attributes {
+		if d.Attributes[i].Name == name {
+			return &d.Attributes[i]
+		}
+	}
+	return nil
+}
+
+func (d *Definition) ResourceName() string {
+	return "nd_" + d.Name
+}
+
+// FeatureVariable is the name of the variable containing the nd.Feature describing the API paths of the object.
+func (d *Definition) FeatureVariable() string {
+	return lowerFirst(d.StructName) + "Feature"
+}
+
+// Endpoints returns the API paths of the object followed by the supported ND versions when the path is versioned.
+func (d *Definition) Endpoints() []string {
+	endpoints := []string{}
+	for _, apiPath := range d.Api.Paths {
+		feature := &nd.Feature{Capabilities: []nd.Capability{{Minimum: apiPath.MinimumVersion, Maximum: apiPath.MaximumVersion}}}
+		if apiPath.MinimumVersion == "" && apiPath.MaximumVersion == "" {
+			endpoints = append(endpoints, fmt.Sprintf("`%s`", apiPath.Path))
+		} else {
+			endpoints = append(endpoints, fmt.Sprintf("`%s` (ND %s)", apiPath.Path, feature.Requirement()))
+		}
+	}
+	return endpoints
+}
+
+// HasPreserved reports whether the model contains attributes that are not read back from ND.
+func (d *Definition) HasPreserved() bool {
+	return len(d.PreservedAttributes()) > 0
+}
+
+// PreservedAttributes are the attributes that are not returned by ND and are kept from the plan or state.
+func (d *Definition) PreservedAttributes() []Attribute {
+	attributes := []Attribute{}
+	for _, attribute := range d.Attributes {
+		if attribute.NotReturned && !attribute.WriteOnly {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+// ReadAttributes are the attributes that are set from the ND response.
+func (d *Definition) ReadAttributes() []Attribute {
+	attributes := []Attribute{}
+	for _, attribute := range d.Attributes {
+		if !attribute.NotReturned && !attribute.WriteOnly {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+// DataSourceAttributes are the attributes exposed by the data source, write only and not returned attributes are excluded.
+func (d *Definition) DataSourceAttributes() []Attribute {
+	return d.ReadAttributes()
+}
+
+func (d *Definition) RequiredAttributes() []Attribute {
+	attributes := []Attribute{}
+	for _, attribute := range d.Attributes {
+		if attribute.Required {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+func (d *Definition) OptionalAttributes() []Attribute {
+	attributes := []Attribute{}
+	for _, attribute := range d.Attributes {
+		if attribute.Optional {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+func (d *Definition) ReadOnlyAttributes() []Attribute {
+	attributes := []Attribute{}
+	for _, attribute := range d.Attributes {
+		if attribute.ReadOnly() {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+// DiscriminatorValues returns the discriminator values with the attributes which are not applicable or required.
+func (d *Definition) DiscriminatorValues() []DiscriminatorValue {
+	if d.Discriminator == "" {
+		return nil
+	}
+	values := []DiscriminatorValue{}
+	for _, value := range d.Attribute(d.Discriminator).Validators.OneOf {
+		discriminatorValue := DiscriminatorValue{Value: value}
+		for _, attribute := range d.Attributes {
+			if len(attribute.AppliesTo) > 0 && !contains(attribute.AppliesTo, value) {
+				discriminatorValue.Invalid = append(discriminatorValue.Invalid, attribute)
+			}
+			if contains(attribute.RequiredFor, value) {
+				discriminatorValue.Required = append(discriminatorValue.Required, attribute)
+			}
+		}
+		if len(discriminatorValue.Invalid) > 0 || len(discriminatorValue.Required) > 0 {
+			values = append(values, discriminatorValue)
+		}
+	}
+	return values
+}
+
+// InapplicableDiscriminatorValues returns the discriminator values for which some attributes are not applicable.
+func (d *Definition) InapplicableDiscriminatorValues() []DiscriminatorValue {
+	values := []DiscriminatorValue{}
+	for _, value := range d.DiscriminatorValues() {
+		if len(value.Invalid) > 0 {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+type DiscriminatorValue struct {
+	Value    string
+	Invalid  []Attribute
+	Required []Attribute
+}
+
+// ReadOnly reports whether the attribute cannot be configured.
+func (a Attribute) ReadOnly() bool {
+	return a.Computed && !a.Required && !a.Optional
+}
+
+// OptionalComputed reports whether ND provides the value of an optional attribute when it is not configured.
+func (a Attribute) OptionalComputed() bool {
+	return a.Optional && a.Computed && !a.WriteOnly && !a.NotReturned
+}
+
+// SchemaComputed reports whether the attribute is computed in the schema, optional attributes with a default are computed.
+func (a Attribute) SchemaComputed() bool {
+	return a.OptionalComputed() || a.Optional && a.Default != nil
+}
+
+func (a Attribute) IsCollection() bool {
+	return a.Type == "set" || a.Type == "list"
+}
+
+func (a Attribute) GoName() string {
+	return toCamelCase(a.Name)
+}
+
+// JsonName is the last reference token of the JSON pointer shown in the documentation.
+func (a Attribute) JsonName() string {
+	return a.JsonPointer[strings.LastIndex(a.JsonPointer, "/")+1:]
+}
+
+// FrameworkType is the name of the attribute type in the framework packages, ie "String" or "Set".
+func (a Attribute) FrameworkType() string {
+	switch a.Type {
+	case "string":
+		return "String"
+	case "bool":
+		return "Bool"
+	case "int64":
+		return "Int64"
+	case "float64":
+		return "Float64"
+	case "set":
+		return "Set"
+	case "list":
+		return "List"
+	}
+	return ""
+}
+
+// DocType is the type shown in the documentation.
+func (a Attribute) DocType() string {
+	switch a.Type {
+	case "int64", "float64":
+		return "Number"
+	}
+	return a.FrameworkType()
+}
+
+func (a Attribute) HasValidators() bool {
+	return len(a.Validators.OneOf) > 0 || len(a.Validators.LengthBetween) > 0 || len(a.Validators.IntBetween) > 0 || a.Validators.Regex != nil
+}
+
+// AppliesToText returns the documentation sentence describing the discriminator values the attribute is applicable to.
+func (a Attribute) AppliesToText() string {
+	return strings.Join(a.AppliesTo, ", or ")
+}
+
+// RequiredForText returns the documentation sentence describing the discriminator values the attribute is required for.
+func (a Attribute) RequiredForText() string {
+	return strings.Join(a.RequiredFor, ", or ")
+}
+
+// Discriminator is the name of the discriminator attribute of the definition.
+func (a Attribute) Discriminator() string {
+	return a.discriminator
+}
+
+// NullValue renders the null value of the attribute type.
+func (a Attribute) NullValue() string {
+	if a.IsCollection() {
+		return fmt.Sprintf("basetypes.New%sNull(types.StringType)", a.FrameworkType())
+	}
+	return fmt.Sprintf("basetypes.New%sNull()", a.FrameworkType())
+}
+
+// expression is a value rendered as is in the HCL configuration, ie a reference to another resource.
+type expression string
+
+// HclValue renders a value as a HCL expression.
+func HclValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case expression:
+		return string(v)
+	case string:
+		return fmt.Sprintf("%q", v)
+	case []interface{}:
+		elements := make([]string, 0, len(v))
+		for _, element := range v {
+			elements = append(elements, HclValue(element))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(elements, ", "))
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// CheckValue renders a value as the string expected by the acceptance tests in the state of the resource.
+func CheckValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func toCamelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i, part := range parts {
+		switch part {
+		case "id", "dn", "url", "ip", "dns", "ntp", "vrf":
+			parts[i] = strings.ToUpper(part)
+		default:
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+func lowerFirst(name string) string {
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,631 @@
+// The generator renders the resources, data sources, examples, acceptance tests and documentation of the ND objects
+// described in the YAML files of the definitions directory. Execute `go generate` from the repository root to run it.
+package main
+
+import (
+	"bytes"
+	"embed"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const generatedHeader = `// Code generated by "gen/generator.go"; DO NOT EDIT.`
+
+const defaultApiReference = "https://developer.cisco.com/docs/nexus-dashboard/4-1-1/api-reference/"
+
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+var templateFuncs = template.FuncMap{
+	"lower":                  strings.ToLower,
+	"trimSpace":              strings.TrimSpace,
+	"lowerFirst":             lowerFirst,
+	"escape":                 escape,
+	"attributeDescription":   attributeDescription,
+	"defaultValue":           defaultValue,
+	"validators":             validators,
+	"readAttribute":          readAttribute,
+	"docAttribute":           docAttribute,
+	"docDataSourceAttribute": docDataSourceAttribute,
+}
+
+// output describes a single file rendered from a template.
+type output struct {
+	template string
+	path     string
+}
+
+func main() {
+	definitionsDir := flag.String("definitions", "gen/definitions", "directory containing the YAML definitions")
+	outputDir := flag.String("output", ".", "root directory of the provider repository")
+	flag.Parse()
+
+	definitions, err := loadDefinitions(*definitionsDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := generate(definitions, *outputDir); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(definitions []*Definition, outputDir string) error {
+	templates, err := template.New("").Funcs(templateFuncs).ParseFS(templateFiles, "templates/*.tmpl")
+	if err != nil {
+		return err
+	}
+
+	providerDir := filepath.Join(outputDir, "internal", "provider")
+	staleFiles, err := findGeneratedFiles(providerDir)
+	if err != nil {
+		return err
+	}
+
+	for _, definition := range definitions {
+		for _, out := range definition.outputs(outputDir) {
+			if err := render(templates, out.template, out.path, definition); err != nil {
+				return fmt.Errorf("%s: %w", definition.ResourceName(), err)
+			}
+			delete(staleFiles, out.path)
+		}
+	}
+
+	providerFile := filepath.Join(providerDir, "provider_generated.go")
+	if err := render(templates, "provider_generated.go.tmpl", providerFile, definitions); err != nil {
+		return err
+	}
+	delete(staleFiles, providerFile)
+
+	// Remove the files of definitions that no longer exist.
+	for file := range staleFiles {
+		if err := os.Remove(file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Definition) outputs(outputDir string) []output {
+	providerDir := filepath.Join(outputDir, "internal", "provider")
+	outputs := []output{
+		{"resource.go.tmpl", filepath.Join(providerDir, fmt.Sprintf("resource_%s.go", d.ResourceName()))},
+		{"resource_test.go.tmpl", filepath.Join(providerDir, fmt.Sprintf("resource_%s_test.go", d.ResourceName()))},
+		{"resource_example.tf.tmpl", filepath.Join(outputDir, "examples", "resources", d.ResourceName(), "main.tf")},
+		{"provider_example.tf.tmpl", filepath.Join(outputDir, "examples", "resources", d.ResourceName(), "provider.tf")},
+		{"resource_doc.md.tmpl", filepath.Join(outputDir, "docs", "resources", fmt.Sprintf("%s.md", d.ResourceName()))},
+	}
+	if !d.SkipDataSource {
+		outputs = append(outputs,
+			output{"data_source.go.tmpl", filepath.Join(providerDir, fmt.Sprintf("data_source_%s.go", d.ResourceName()))},
+			output{"data_source_test.go.tmpl", filepath.Join(providerDir, fmt.Sprintf("data_source_%s_test.go", d.ResourceName()))},
+			output{"data_source_example.tf.tmpl", filepath.Join(outputDir, "examples", "data-sources", d.ResourceName(), "main.tf")},
+			output{"provider_example.tf.tmpl", filepath.Join(outputDir, "examples", "data-sources", d.ResourceName(), "provider.tf")},
+			output{"data_source_doc.md.tmpl", filepath.Join(outputDir, "docs", "data-sources", fmt.Sprintf("%s.md", d.ResourceName()))},
+		)
+	}
+	return outputs
+}
+
+func render(templates *template.Template, name, path string, data interface{}) error {
+	var buffer bytes.Buffer
+	if err := templates.ExecuteTemplate(&buffer, name, data); err != nil {
+		return err
+	}
+
+	content := buffer.Bytes()
+	if strings.HasSuffix(path, ".go") {
+		formatted, err := format.Source(content)
+		if err != nil {
+			return fmt.Errorf("formatting %s: %w\n%s", path, err, content)
+		}
+		content = formatted
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0644)
+}
+
+// findGeneratedFiles returns the go files in the directory that were created by the generator.
+func findGeneratedFiles(dir string) (map[string]bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	generated := map[string]bool{}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(content, []byte(generatedHeader)) {
+			generated[file] = true
+		}
+	}
+	return generated, nil
+}
+
+func (d *Definition) Title() string {
+	words := strings.Fields(d.Label)
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+func (d *Definition) ApiReferenceUrl() string {
+	if d.ApiReference != "" {
+		return d.ApiReference
+	}
+	return defaultApiReference
+}
+
+// ImportIdName is the name of the identifier used to import the object.
+func (d *Definition) ImportIdName() string {
+	if d.Api.IdAttribute != "" {
+		return d.Attribute(d.Api.IdAttribute).JsonName()
+	}
+	return "id"
+}
+
+// ImportNote explains which attributes cannot be imported because ND does not return them.
+func (d *Definition) ImportNote() string {
+	names := []string{}
+	for _, attribute := range d.Attributes {
+		if attribute.NotReturned || attribute.WriteOnly {
+			names = append(names, fmt.Sprintf("`%s`", attribute.Name))
+		}
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	list := names[0]
+	if len(names) > 1 {
+		list = fmt.Sprintf("%s and %s", strings.Join(names[:len(names)-1], ", "), names[len(names)-1])
+	}
+	return fmt.Sprintf("The values for %s are not returned by Nexus Dashboard and will not be imported by the `%s` resource.", list, d.ResourceName())
+}
+
+func (d *Definition) ImportStateVerifyIgnore() []string {
+	names := []string{}
+	for _, attribute := range d.PreservedAttributes() {
+		names = append(names, attribute.Name)
+	}
+	return names
+}
+
+func (d *Definition) HasWriteOnly() bool {
+	for _, attribute := range d.Attributes {
+		if attribute.WriteOnly {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Definition) UsesValidators() bool {
+	for _, attribute := range d.Attributes {
+		if attribute.HasValidators() {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Definition) UsesStringValidator() bool {
+	for _, attribute := range d.Attributes {
+		if attribute.Type != "int64" && attribute.HasValidators() {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Definition) UsesInt64Validator() bool {
+	for _, attribute := range d.Attributes {
+		if attribute.Type == "int64" && attribute.HasValidators() {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Definition) UsesSetValidator() bool {
+	for _, attribute := range d.Attributes {
+		if attribute.Type == "set" && attribute.HasValidators() {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Definition) UsesListValidator() bool {
+	for _, attribute := range d.Attributes {
+		if attribute.Type == "list" && attribute.HasValidators() {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Definition) UsesRegex() bool {
+	for _, attribute := range d.Attributes {
+		if attribute.Validators.Regex != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// PlanModifierPackages returns the plan modifier packages used by the resource schema.
+func (d *Definition) PlanModifierPackages() []string {
+	packages := map[string]bool{"planmodifier": true, "stringplanmodifier": true}
+	for _, attribute := range d.Attributes {
+		if attribute.RequiresReplace || attribute.OptionalComputed() || attribute.ReadOnly() {
+			packages[strings.ToLower(attribute.FrameworkType())+"planmodifier"] = true
+		}
+	}
+	return sortedKeys(packages)
+}
+
+// DefaultPackages returns the default value packages used by the resource schema.
+func (d *Definition) DefaultPackages() []string {
+	packages := map[string]bool{}
+	for _, attribute := range d.Attributes {
+		if attribute.Default != nil {
+			packages[strings.ToLower(attribute.FrameworkType())+"default"] = true
+		}
+	}
+	return sortedKeys(packages)
+}
+
+// discriminatorTestValue is the value of the discriminator used in the acceptance tests and examples.
+func (d *Definition) discriminatorTestValue() string {
+	if d.Discriminator == "" {
+		return ""
+	}
+	discriminator := d.Attribute(d.Discriminator)
+	if discriminator.TestValue != nil {
+		return fmt.Sprintf("%v", discriminator.TestValue)
+	}
+	if discriminator.Default != nil {
+		return fmt.Sprintf("%v", discriminator.Default)
+	}
+	return discriminator.Validators.OneOf[0]
+}
+
+// configValues returns the attribute values used in the configuration of the provided kind.
+//
+// Kinds:
+//   - "min": required attributes only
+//   - "all": required and optional attributes applicable to the discriminator test value
+//   - "update": same as "all" with the test update values when defined
+//   - "example_min" and "example_all": same as "min" and "all" using the example values
+func (d *Definition) configValues(kind string) []attributeValue {
+	values := []attributeValue{}
+	discriminatorValue := d.discriminatorTestValue()
+	example := strings.HasPrefix(kind, "example")
+	for _, attribute := range d.Attributes {
+		if attribute.ReadOnly() {
+			continue
+		}
+		if len(attribute.AppliesTo) > 0 && !contains(attribute.AppliesTo, discriminatorValue) {
+			continue
+		}
+		value := attribute.TestValue
+		if example && attribute.ExampleValue != nil {
+			value = attribute.ExampleValue
+		}
+		if kind == "update" && attribute.TestUpdateValue != nil && !attribute.RequiresReplace {
+			value = attribute.TestUpdateValue
+		}
+		if value == nil {
+			continue
+		}
+		if attribute.Required || kind == "all" || kind == "update" || kind == "example_all" {
+			values = append(values, attributeValue{attribute, value})
+		}
+	}
+	return values
+}
+
+type attributeValue struct {
+	attribute Attribute
+	value     interface{}
+}
+
+func (d *Definition) TestConfig(kind string) string {
+	return hclBlock("resource", d.ResourceName(), "test", d.configValues(kind))
+}
+
+func (d *Definition) ExampleConfig(kind string) string {
+	label := "example"
+	if kind == "min" {
+		label = "example_minimum"
+	}
+	return hclBlock("resource", d.ResourceName(), label, d.configValues("example_"+kind))
+}
+
+func (d *Definition) DataSourceTestConfig() string {
+	name := d.Api.IdAttribute
+	if name == "" {
+		name = "id"
+	}
+	values := []attributeValue{
+		{Attribute{Name: name}, expression(fmt.Sprintf("%s.test.%s", d.ResourceName(), name))},
+		{Attribute{Name: "depends_on"}, expression(fmt.Sprintf("[%s.test]", d.ResourceName()))},
+	}
+	return hclBlock("data", d.ResourceName(), "test", values)
+}
+
+func (d *Definition) DataSourceExampleConfig() string {
+	values := []attributeValue{}
+	if d.Api.IdAttribute != "" {
+		for _, value := range d.configValues("example_min") {
+			if value.attribute.Name == d.Api.IdAttribute {
+				values = append(values, value)
+			}
+		}
+	} else {
+		values = append(values, attributeValue{Attribute{Name: "id", Type: "string"}, "{id}"})
+	}
+	return hclBlock("data", d.ResourceName(), "example", values)
+}
+
+// TestChecks returns the acceptance test checks of the resource or data source address for the provided kind.
+func (d *Definition) TestChecks(kind, address string) []string {
+	checks := []string{}
+	switch kind {
+	case "min":
+		configured := map[string]bool{}
+		for _, value := range d.configValues("min") {
+			checks = append(checks, attributeChecks(address, value.attribute, value.value)...)
+			configured[value.attribute.Name] = true
+		}
+		for _, attribute := range d.Attributes {
+			if !configured[attribute.Name] && attribute.Default != nil {
+				checks = append(checks, attributeChecks(address, attribute, attribute.Default)...)
+			}
+		}
+	case "data":
+		for _, value := range d.configValues("all") {
+			if !value.attribute.WriteOnly && !value.attribute.NotReturned {
+				checks = append(checks, attributeChecks(address, value.attribute, value.value)...)
+			}
+		}
+	default:
+		for _, value := range d.configValues(kind) {
+			checks = append(checks, attributeChecks(address, value.attribute, value.value)...)
+		}
+	}
+	return checks
+}
+
+type errorTest struct {
+	Name   string
+	Config string
+	Error  string
+}
+
+// ErrorTests returns the configurations that set attributes which are not applicable to the configured discriminator value
+// and the configurations that do not set attributes which are required for the configured discriminator value.
+func (d *Definition) ErrorTests() []errorTest {
+	tests := []errorTest{}
+	discriminator := d.Attribute(d.Discriminator)
+	for _, discriminatorValue := range d.DiscriminatorValues() {
+		for _, attribute := range discriminatorValue.Invalid {
+			value := attribute.TestValue
+			if value == nil {
+				continue
+			}
+			values := append(d.discriminatorConfigValues(discriminatorValue.Value), attributeValue{attribute, value})
+			tests = append(tests, errorTest{
+				Name:   fmt.Sprintf("testConfigResourceNd%s%sWith%sError", d.StructName, toCamelCase(discriminatorValue.Value), attribute.GoName()),
+				Config: hclBlock("resource", d.ResourceName(), "test", values),
+				Error:  fmt.Sprintf("The '%s' is invalid attribute for '%s': %s", attribute.Name, discriminator.Name, discriminatorValue.Value),
+			})
+		}
+		for _, attribute := range discriminatorValue.Required {
+			tests = append(tests, errorTest{
+				Name:   fmt.Sprintf("testConfigResourceNd%s%sWithout%sError", d.StructName, toCamelCase(discriminatorValue.Value), attribute.GoName()),
+				Config: hclBlock("resource", d.ResourceName(), "test", d.discriminatorConfigValues(discriminatorValue.Value)),
+				Error:  fmt.Sprintf("The '%s' is required attribute for '%s': %s", attribute.Name, discriminator.Name, discriminatorValue.Value),
+			})
+		}
+	}
+	return tests
+}
+
+// discriminatorConfigValues returns the required attribute values with the provided discriminator value.
+func (d *Definition) discriminatorConfigValues(value string) []attributeValue {
+	discriminator := d.Attribute(d.Discriminator)
+	values := []attributeValue{}
+	for _, requiredValue := range d.configValues("min") {
+		if requiredValue.attribute.Name != discriminator.Name {
+			values = append(values, requiredValue)
+		}
+	}
+	return append(values, attributeValue{*discriminator, value})
+}
+
+func attributeChecks(address string, attribute Attribute, value interface{}) []string {
+	if attribute.WriteOnly {
+		return []string{fmt.Sprintf(`resource.TestCheckNoResourceAttr("%s", "%s")`, address, attribute.Name)}
+	}
+	elements, ok := value.([]interface{})
+	if !ok {
+		return []string{fmt.Sprintf(`resource.TestCheckResourceAttr("%s", "%s", "%s")`, address, attribute.Name, escape(CheckValue(value)))}
+	}
+	checks := []string{fmt.Sprintf(`resource.TestCheckResourceAttr("%s", "%s.#", "%d")`, address, attribute.Name, len(elements))}
+	for index, element := range elements {
+		if attribute.Type == "set" {
+			checks = append(checks, fmt.Sprintf(`resource.TestCheckTypeSetElemAttr("%s", "%s.*", "%s")`, address, attribute.Name, escape(CheckValue(element))))
+		} else {
+			checks = append(checks, fmt.Sprintf(`resource.TestCheckResourceAttr("%s", "%s.%d", "%s")`, address, attribute.Name, index, escape(CheckValue(element))))
+		}
+	}
+	return checks
+}
+
+// hclBlock renders a block with the attribute assignments aligned the same way as `terraform fmt`.
+func hclBlock(blockType, resourceName, label string, values []attributeValue) string {
+	width := 0
+	for _, value := range values {
+		if len(value.attribute.Name) > width {
+			width = len(value.attribute.Name)
+		}
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%s \"%s\" \"%s\" {\n", blockType, resourceName, label)
+	for _, value := range values {
+		fmt.Fprintf(&builder, "  %-*s = %s\n", width, value.attribute.Name, HclValue(value.value))
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func attributeDescription(attribute Attribute) string {
+	description := attribute.Description
+	if len(attribute.Validators.OneOf) > 0 {
+		allowed := make([]string, 0, len(attribute.Validators.OneOf))
+		for _, value := range attribute.Validators.OneOf {
+			allowed = append(allowed, fmt.Sprintf("'%s'", value))
+		}
+		description = fmt.Sprintf("%s Allowed values are %s.", description, joinWords(allowed, "or"))
+	}
+	if len(attribute.AppliesTo) > 0 {
+		description = fmt.Sprintf("%s This attribute is only applicable when %s is set to %s.", description, attribute.Discriminator(), attribute.AppliesToText())
+	}
+	if len(attribute.RequiredFor) > 0 {
+		description = fmt.Sprintf("%s This attribute is required when %s is set to %s.", description, attribute.Discriminator(), attribute.RequiredForText())
+	}
+	return escape(description)
+}
+
+func defaultValue(attribute Attribute) string {
+	switch attribute.Type {
+	case "string":
+		return fmt.Sprintf("stringdefault.StaticString(%q)", attribute.Default)
+	case "bool":
+		return fmt.Sprintf("booldefault.StaticBool(%v)", attribute.Default)
+	case "int64":
+		return fmt.Sprintf("int64default.StaticInt64(%v)", attribute.Default)
+	case "float64":
+		return fmt.Sprintf("float64default.StaticFloat64(%v)", attribute.Default)
+	}
+	return ""
+}
+
+func validators(attribute Attribute) []string {
+	result := []string{}
+	if len(attribute.Validators.OneOf) > 0 {
+		values := make([]string, 0, len(attribute.Validators.OneOf))
+		for _, value := range attribute.Validators.OneOf {
+			values = append(values, fmt.Sprintf("%q", value))
+		}
+		result = append(result, fmt.Sprintf("stringvalidator.OneOf(%s)", strings.Join(values, ", ")))
+	}
+	if len(attribute.Validators.LengthBetween) == 2 {
+		result = append(result, fmt.Sprintf("stringvalidator.LengthBetween(%d, %d)", attribute.Validators.LengthBetween[0], attribute.Validators.LengthBetween[1]))
+	}
+	if attribute.Validators.Regex != nil {
+		result = append(result, fmt.Sprintf("stringvalidator.RegexMatches(\nregexp.MustCompile(`%s`),\n%q,\n)", attribute.Validators.Regex.Pattern, attribute.Validators.Regex.Message))
+	}
+	if len(attribute.Validators.IntBetween) == 2 {
+		result = append(result, fmt.Sprintf("int64validator.Between(%d, %d)", attribute.Validators.IntBetween[0], attribute.Validators.IntBetween[1]))
+	}
+	return result
+}
+
+// readAttribute renders the code which sets the model attribute from the value found in the ND response.
+func readAttribute(attribute Attribute, model, response string) string {
+	var assignment string
+	switch attribute.Type {
+	case "string":
+		assignment = fmt.Sprintf("value, ok := getResponseValue(%s, %q).(string); ok {\n%s.%s = basetypes.NewStringValue(value)", response, attribute.JsonPointer, model, attribute.GoName())
+	case "bool":
+		assignment = fmt.Sprintf("value, ok := getResponseValue(%s, %q).(bool); ok {\n%s.%s = basetypes.NewBoolValue(value)", response, attribute.JsonPointer, model, attribute.GoName())
+	case "int64":
+		assignment = fmt.Sprintf("value, ok := getResponseValue(%s, %q).(float64); ok {\n%s.%s = basetypes.NewInt64Value(int64(value))", response, attribute.JsonPointer, model, attribute.GoName())
+	case "float64":
+		assignment = fmt.Sprintf("value, ok := getResponseValue(%s, %q).(float64); ok {\n%s.%s = basetypes.NewFloat64Value(value)", response, attribute.JsonPointer, model, attribute.GoName())
+	case "set", "list":
+		assignment = fmt.Sprintf("value, ok := getResponseValue(%s, %q).([]interface{}); ok {\n%s.%s, _ = types.%sValueFrom(ctx, basetypes.StringType{}, getStringElements(value))", response, attribute.JsonPointer, model, attribute.GoName(), attribute.FrameworkType())
+	}
+	return fmt.Sprintf("\t\tif %s\n\t\t}", assignment)
+}
+
+func docAttribute(attribute Attribute) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "* `%s` (%s) - (%s) %s", attribute.Name, attribute.JsonName(), attribute.DocType(), attribute.Description)
+	if len(attribute.AppliesTo) > 0 {
+		values := make([]string, 0, len(attribute.AppliesTo))
+		for _, value := range attribute.AppliesTo {
+			values = append(values, fmt.Sprintf("`%s`", value))
+		}
+		fmt.Fprintf(&builder, " This attribute is only applicable when `%s` is set to %s.", attribute.Discriminator(), joinWords(values, "or"))
+	}
+	if len(attribute.RequiredFor) > 0 {
+		values := make([]string, 0, len(attribute.RequiredFor))
+		for _, value := range attribute.RequiredFor {
+			values = append(values, fmt.Sprintf("`%s`", value))
+		}
+		fmt.Fprintf(&builder, " This attribute is required when `%s` is set to %s.", attribute.Discriminator(), joinWords(values, "or"))
+	}
+	if attribute.WriteOnly {
+		builder.WriteString(" This attribute is write-only and requires Terraform 1.11 or later.")
+	}
+	if attribute.Default == "" {
+		builder.WriteString("\n  * Default: `\"\"`")
+	} else if attribute.Default != nil {
+		fmt.Fprintf(&builder, "\n  * Default: `%v`", attribute.Default)
+	}
+	if len(attribute.Validators.OneOf) > 0 {
+		values := make([]string, 0, len(attribute.Validators.OneOf))
+		for _, value := range attribute.Validators.OneOf {
+			values = append(values, fmt.Sprintf("`%s`", value))
+		}
+		fmt.Fprintf(&builder, "\n  * Valid Values: %s.", joinWords(values, "or"))
+	}
+	if len(attribute.Validators.IntBetween) == 2 {
+		fmt.Fprintf(&builder, "\n  * Value Range: `%d` - `%d`.", attribute.Validators.IntBetween[0], attribute.Validators.IntBetween[1])
+	}
+	if len(attribute.Validators.LengthBetween) == 2 {
+		fmt.Fprintf(&builder, "\n  * Length Range: `%d` - `%d`.", attribute.Validators.LengthBetween[0], attribute.Validators.LengthBetween[1])
+	}
+	return builder.String()
+}
+
+func docDataSourceAttribute(attribute Attribute) string {
+	return fmt.Sprintf("* `%s` (%s) - (%s) %s", attribute.Name, attribute.JsonName(), attribute.DocType(), attribute.Description)
+}
+
+// joinWords joins the values the same way as the documentation, ie "`a`, or `b`".
+func joinWords(values []string, conjunction string) string {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return strings.Join(values[:len(values)-1], ", ") + fmt.Sprintf(", %s %s", conjunction, values[len(values)-1])
+}
+
+func escape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+}
+
+func sortedKeys(values map[string]bool) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
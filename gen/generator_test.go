@@ -0,0 +1,288 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	definitions, err := loadDefinitions(filepath.Join("testdata", "definitions"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outputDir := t.TempDir()
+	if err := generate(definitions, outputDir); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]string{
+		"internal/provider/resource_nd_test_object.go": {
+			`{Maximum: "4.1", Path: "/nexus/infra/api/v4/testobjects"},`,
+			`{Minimum: "4.1", Path: "/api/v1/infra/testObjects"},`,
+			`apiPath := client.GetPath(ctx, diags, testObjectFeature)`,
+			`setPayloadValue(diags, payload, configData.Password.ValueString(), "/spec/credentials/password")`,
+			`if value, ok := getResponseValue(responseData, "/spec/remote/port").(float64); ok {`,
+			`resp.Diagnostics.AddError("The 'port' is invalid attribute for 'type': local", "The 'port' attribute is only applicable when 'type' is set to remote.")`,
+			`*data = *getBaseTestObjectResourceModel(data.Username)`,
+			`resp.Diagnostics.AddError("The 'host' is required attribute for 'type': remote", "The 'host' attribute must be configured when 'type' is set to remote.")`,
+			`data.Host = basetypes.NewStringNull()`,
+		},
+		"internal/provider/resource_nd_test_object_test.go": {
+			`ImportStateVerifyIgnore: []string{"username"},`,
+			`resource.TestCheckNoResourceAttr("nd_test_object.test", "password"),`,
+			`ExpectError: regexp.MustCompile("The 'host' is invalid attribute for 'type': local"),`,
+			`ExpectError: regexp.MustCompile("The 'host' is required attribute for 'type': remote"),`,
+		},
+		"internal/provider/data_source_nd_test_object.go": {
+			`data.Id = types.StringValue(data.Name.ValueString())`,
+		},
+		"internal/provider/data_source_nd_test_object_test.go": {
+			`name       = nd_test_object.test.name`,
+		},
+		"internal/provider/provider_generated.go": {
+			"NewTestObjectResource,",
+			"NewTestObjectDataSource,",
+		},
+		"examples/resources/nd_test_object/main.tf": {
+			`resource "nd_test_object" "example_minimum" {`,
+		},
+		"examples/resources/nd_test_object/provider.tf":    {`provider "nd" {`},
+		"examples/data-sources/nd_test_object/main.tf":     {`data "nd_test_object" "example" {`},
+		"examples/data-sources/nd_test_object/provider.tf": {`provider "nd" {`},
+		"docs/resources/nd_test_object.md": {
+			"* API Endpoint: `/nexus/infra/api/v4/testobjects` (ND < 4.1)",
+			"* API Endpoint: `/api/v1/infra/testObjects` (ND >= 4.1)",
+			"* `port` (port) - (Number) The port of the remote test object. This attribute is only applicable when `type` is set to `remote`.",
+			"  * Valid Values: `local`, or `remote`.",
+			"~> The values for `username` and `password` are not returned by Nexus Dashboard and will not be imported by the `nd_test_object` resource.",
+		},
+		"docs/data-sources/nd_test_object.md": {
+			"* `status` (state) - (String) The operational state of the test object.",
+		},
+	}
+
+	for file, contents := range expected {
+		content, err := os.ReadFile(filepath.Join(outputDir, file))
+		if err != nil {
+			t.Errorf("expected generated file %s: %v", file, err)
+			continue
+		}
+		for _, expectedContent := range contents {
+			if !strings.Contains(string(content), expectedContent) {
+				t.Errorf("expected %s to contain %q", file, expectedContent)
+			}
+		}
+	}
+}
+
+// TestGenerateBuilds generates the definitions of the repository and the test definitions next to the manually written
+// files of the provider package and verifies that the package and its tests compile. The output directory is created
+// inside the module because the generated files import the internal packages of the provider.
+func TestGenerateBuilds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping compilation of the generated provider package in short mode")
+	}
+	goBinary, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go binary not found")
+	}
+
+	definitions, err := loadDefinitions("definitions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testDefinitions, err := loadDefinitions(filepath.Join("testdata", "definitions"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	definitions = append(definitions, testDefinitions...)
+
+	outputDir, err := os.MkdirTemp("testdata", "build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(outputDir) })
+
+	providerDir := filepath.Join(outputDir, "internal", "provider")
+	if err := copyManualFiles(filepath.Join("..", "internal", "provider"), providerDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := generate(definitions, outputDir); err != nil {
+		t.Fatal(err)
+	}
+
+	// go vet type checks the package and its tests.
+	command := exec.Command(goBinary, "vet", "./"+filepath.ToSlash(providerDir))
+	if output, err := command.CombinedOutput(); err != nil {
+		t.Fatalf("generated provider package does not compile: %v\n%s", err, output)
+	}
+}
+
+// TestGeneratedFilesUpToDate verifies that the generated files of the repository match the definitions.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	definitions, err := loadDefinitions("definitions")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outputDir := t.TempDir()
+	if err := generate(definitions, outputDir); err != nil {
+		t.Fatal(err)
+	}
+
+	err = filepath.WalkDir(outputDir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		file, err := filepath.Rel(outputDir, path)
+		if err != nil {
+			return err
+		}
+		generated, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		committed, err := os.ReadFile(filepath.Join("..", file))
+		if err != nil || !bytes.Equal(generated, committed) {
+			t.Errorf("%s is not up to date with the definitions, execute `go generate` from the repository root", file)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// copyManualFiles copies the go files of the directory that were not created by the generator.
+func copyManualFiles(sourceDir, targetDir string) error {
+	generated, err := findGeneratedFiles(sourceDir)
+	if err != nil {
+		return err
+	}
+	files, err := filepath.Glob(filepath.Join(sourceDir, "*.go"))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(targetDir, 0755); err != nil {
+		return err
+	}
+	for _, file := range files {
+		if generated[file] {
+			continue
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(targetDir, filepath.Base(file)), content, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestGenerateRemovesStaleFiles(t *testing.T) {
+	outputDir := t.TempDir()
+	providerDir := filepath.Join(outputDir, "internal", "provider")
+	if err := os.MkdirAll(providerDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	staleFile := filepath.Join(providerDir, "resource_nd_removed.go")
+	if err := os.WriteFile(staleFile, []byte(generatedHeader+"\n\npackage provider\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	manualFile := filepath.Join(providerDir, "resource_nd_manual.go")
+	if err := os.WriteFile(manualFile, []byte("package provider\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := generate(nil, outputDir); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(staleFile); !os.IsNotExist(err) {
+		t.Errorf("expected generated file of a removed definition to be deleted")
+	}
+	if _, err := os.Stat(manualFile); err != nil {
+		t.Errorf("expected manually written file to be kept: %v", err)
+	}
+}
+
+func TestDefinitionValidate(t *testing.T) {
+	testCases := map[string]Definition{
+		"missing struct_name": {
+			Name: "object",
+			Api:  Api{Path: "/api/v1/objects", IdAttribute: "name"},
+		},
+		"path and paths": {
+			Name:       "object",
+			StructName: "Object",
+			Api:        Api{Path: "/api/v1/objects", Paths: []ApiPath{{Path: "/api/v1/objects"}}, IdAttribute: "name"},
+			Attributes: []Attribute{{Name: "name", Type: "string", JsonPointer: "/name", Required: true, TestValue: "test"}},
+		},
+		"invalid path version": {
+			Name:       "object",
+			StructName: "Object",
+			Api:        Api{Paths: []ApiPath{{MinimumVersion: "4.x", Path: "/api/v1/objects"}}, IdAttribute: "name"},
+			Attributes: []Attribute{{Name: "name", Type: "string", JsonPointer: "/name", Required: true, TestValue: "test"}},
+		},
+		"unknown id_attribute": {
+			Name:       "object",
+			StructName: "Object",
+			Api:        Api{Path: "/api/v1/objects", IdAttribute: "name"},
+		},
+		"unsupported type": {
+			Name:       "object",
+			StructName: "Object",
+			Api:        Api{Path: "/api/v1/objects", IdAttribute: "name"},
+			Attributes: []Attribute{{Name: "name", Type: "map", JsonPointer: "/name", Required: true, TestValue: "test"}},
+		},
+		"required without test value": {
+			Name:       "object",
+			StructName: "Object",
+			Api:        Api{Path: "/api/v1/objects", IdAttribute: "name"},
+			Attributes: []Attribute{{Name: "name", Type: "string", JsonPointer: "/name", Required: true}},
+		},
+		"applies_to without discriminator": {
+			Name:       "object",
+			StructName: "Object",
+			Api:        Api{Path: "/api/v1/objects", IdAttribute: "name"},
+			Attributes: []Attribute{
+				{Name: "name", Type: "string", JsonPointer: "/name", Required: true, TestValue: "test"},
+				{Name: "port", Type: "int64", JsonPointer: "/port", Optional: true, AppliesTo: []string{"remote"}},
+			},
+		},
+		"required_for without discriminator": {
+			Name:       "object",
+			StructName: "Object",
+			Api:        Api{Path: "/api/v1/objects", IdAttribute: "name"},
+			Attributes: []Attribute{
+				{Name: "name", Type: "string", JsonPointer: "/name", Required: true, TestValue: "test"},
+				{Name: "host", Type: "string", JsonPointer: "/host", Optional: true, RequiredFor: []string{"remote"}},
+			},
+		},
+		"write only with default": {
+			Name:       "object",
+			StructName: "Object",
+			Api:        Api{Path: "/api/v1/objects", IdAttribute: "name"},
+			Attributes: []Attribute{
+				{Name: "name", Type: "string", JsonPointer: "/name", Required: true, TestValue: "test"},
+				{Name: "password", Type: "string", JsonPointer: "/password", Optional: true, WriteOnly: true, Default: "secret"},
+			},
+		},
+	}
+
+	for name, definition := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if err := definition.validate(); err == nil {
+				t.Errorf("expected validation error")
+			}
+		})
+	}
+}
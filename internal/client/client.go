@@ -1,353 +1,122 @@
 package client
 
 import (
-	"bytes"
 	"context"
-	"crypto/tls"
-	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"log"
-	"math"
-	"math/rand"
-	"time"
-
 	"net/http"
-	"net/url"
 	"strings"
-	"sync"
 
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
 	"github.com/Jeffail/gabs/v2"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"golang.org/x/net/html"
 )
 
-const ndAuthPayload = `{
-	"userName": "%s",
-	"userPasswd": "%s"
-}`
-
-// Default timeout for NGINX in ND is 90 Seconds.
-// Allow the client to set a shorter or longer time depending on their
-// environment
-const DefaultReqTimeoutVal int = 100
-const DefaultBackoffMinDelay int = 4
-const DefaultBackoffMaxDelay int = 60
-const DefaultBackoffDelayFactor float64 = 3
-
-// Client is the main entry point
+// Client is the main entry point, it wraps the ND Go SDK client and translates its errors into diagnostics.
+// The services of the SDK client, ie Clusters and Version, are available directly on the client.
 type Client struct {
-	baseURL            *url.URL
-	httpClient         *http.Client
-	authToken          *Auth
-	mutex              sync.Mutex
-	username           string
-	password           string
-	insecure           bool
-	proxyUrl           string
-	proxyCreds         string
-	domain             string
-	skipLoggingPayload bool
-	maxRetries         int64
-	backoffMinDelay    int64
-	backoffMaxDelay    int64
-	backoffDelayFactor float64
+	*nd.Client
 }
 
 // singleton implementation of a client
 var clientImpl *Client
 
-func initClient(clientUrl, username, password, proxyUrl, proxyCreds, loginDomain string, isInsecure bool, maxRetries int64) *Client {
-
-	bUrl, err := url.Parse(clientUrl)
-	if err != nil {
-		// cannot move forward if url is undefined
-		log.Fatal(err)
-	}
-
-	client := &Client{
-		baseURL:    bUrl,
-		username:   username,
-		httpClient: http.DefaultClient,
-		password:   password,
-		insecure:   isInsecure,
-		proxyUrl:   proxyUrl,
-		proxyCreds: proxyCreds,
-		domain:     loginDomain,
-		maxRetries: maxRetries,
+func initClient(clientUrl, username, password, apiKey, proxyUrl, proxyCreds, loginDomain string, isInsecure bool, maxRetries int64) (*Client, error) {
+	opts := []nd.Option{
+		nd.WithCredentials(username, password),
+		nd.WithLoginDomain(loginDomain),
+		nd.WithInsecure(isInsecure),
+		nd.WithMaxRetries(int(maxRetries)),
+		nd.WithLogger(log.Default()),
 	}
 
-	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			CipherSuites: []uint16{
-				tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
-				tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
-				tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256,
-				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-			},
-			PreferServerCipherSuites: true,
-			InsecureSkipVerify:       client.insecure,
-			MinVersion:               tls.VersionTLS11,
-			MaxVersion:               tls.VersionTLS13,
-		},
+	// The requests are authenticated with the API key instead of the login token when an API key is provided.
+	if apiKey != "" {
+		opts = append(opts, nd.WithAPIKey(username, apiKey))
 	}
 
-	if client.proxyUrl != "" {
-		transport = client.configProxy(transport)
+	if proxyUrl != "" {
+		opts = append(opts, nd.WithProxy(proxyUrl, proxyCreds))
 	}
 
-	client.httpClient = &http.Client{
-		Transport: transport,
+	ndClient, err := nd.NewClient(clientUrl, opts...)
+	if err != nil {
+		return nil, err
 	}
 
-	return client
+	return &Client{Client: ndClient}, nil
 }
 
 // GetClient returns a singleton
-func GetClient(clientUrl, username, password, proxyUrl, proxyCreds, loginDomain string, isInsecure bool, maxRetries int64) *Client {
+func GetClient(clientUrl, username, password, apiKey, proxyUrl, proxyCreds, loginDomain string, isInsecure bool, maxRetries int64) (*Client, error) {
 	if clientImpl == nil {
-		return initClient(clientUrl, username, password, proxyUrl, proxyCreds, loginDomain, isInsecure, maxRetries)
-	}
-	return clientImpl
-}
-
-func (c *Client) configProxy(transport *http.Transport) *http.Transport {
-	log.Printf("[DEBUG]: Using Proxy Server: %s ", c.proxyUrl)
-	pUrl, err := url.Parse(c.proxyUrl)
-	if err != nil {
-		log.Fatal(err)
-	}
-	transport.Proxy = http.ProxyURL(pUrl)
-
-	if c.proxyCreds != "" {
-		basicAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte(c.proxyCreds))
-		transport.ProxyConnectHeader = http.Header{}
-		transport.ProxyConnectHeader.Add("Proxy-Authorization", basicAuth)
-	}
-	return transport
-}
-
-func (c *Client) makeFullUrl(method string, path string) (string, error) {
-	path = strings.TrimLeft(path, "/")
-	path = fmt.Sprintf("/%v", path)
-	url, err := url.Parse(path)
-	if err != nil {
-		return "", err
-	}
-	if method == "PATCH" {
-		validateString := url.Query()
-		validateString.Set("validate", "false")
-		url.RawQuery = validateString.Encode()
-	}
-	fURL := c.baseURL.ResolveReference(url)
-	return fURL.String(), nil
-}
-
-func (c *Client) MakeRestRequest(method string, path string, body *gabs.Container, authenticated bool, skipLoggingPayload bool) (*http.Request, error) {
-	fURL, err := c.makeFullUrl(method, path)
-	if err != nil {
-		return nil, err
+		return initClient(clientUrl, username, password, apiKey, proxyUrl, proxyCreds, loginDomain, isInsecure, maxRetries)
 	}
-	var req *http.Request
-	if method == "GET" || method == "DELETE" {
-		req, err = http.NewRequest(method, fURL, nil)
-	} else {
-		req, err = http.NewRequest(method, fURL, bytes.NewBuffer((body.Bytes())))
-	}
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	log.Printf("[DEBUG] HTTP request %s %s", method, path)
-
-	if skipLoggingPayload {
-		log.Printf("HTTP request %s %s", method, path)
-	} else {
-		log.Printf("HTTP request %s %s %v", method, path, req)
-	}
-
-	if authenticated {
-		req, err = c.InjectAuthenticationHeader(req, path)
-		if err != nil {
-			return req, err
-		}
-	}
-
-	if !skipLoggingPayload {
-		log.Printf("HTTP request after injection %s %s %v", method, path, req)
-	}
-
-	return req, nil
+	return clientImpl, nil
 }
 
-func (c *Client) Authenticate() error {
-	body, err := gabs.ParseJSON([]byte(fmt.Sprintf(ndAuthPayload, c.username, c.password)))
-	if err != nil {
-		return err
-	}
-
-	if c.domain != "" {
-		body.Set(c.domain, "domain")
+func (c *Client) DoRestRequest(ctx context.Context, diags *diag.Diagnostics, path, method string, payload *gabs.Container) *gabs.Container {
+	if !strings.HasPrefix(path, "/") {
+		path = fmt.Sprintf("/%s", path)
 	}
 
-	req, err := c.MakeRestRequest("POST", "/login", body, false, c.skipLoggingPayload)
-	if err != nil {
-		return err
+	var body interface{}
+	if payload != nil {
+		body = json.RawMessage(payload.Bytes())
 	}
-	req.Header.Set("Content-Type", "application/json")
-
-	obj, _, err := c.Do(req, c.skipLoggingPayload)
 
+	restRequest, err := c.NewRequest(ctx, method, path, body)
 	if err != nil {
-		return err
-	}
-
-	if obj == nil {
-		return errors.New("Empty response")
-	}
-
-	token := obj.S("token").String()
-
-	if token == "" || token == "{}" {
-		return errors.New("Invalid Username or Password")
-	}
-
-	if c.authToken == nil {
-		c.authToken = &Auth{}
-	}
-
-	c.authToken.Token = token
-	c.authToken.CalculateExpiry(1200) //refreshTime=1200 Sec
-
-	return nil
-}
-
-func (c *Client) Do(req *http.Request, skipLoggingPayload bool) (*gabs.Container, *http.Response, error) {
-	log.Printf("[DEBUG] Beginning DO method %s", req.URL.String())
-	log.Printf("[TRACE] HTTP Request Method and URL: %s %s", req.Method, req.URL.String())
-
-	var body []byte
-	if req.Body != nil && c.maxRetries != 0 {
-		body, _ = io.ReadAll(req.Body)
-	}
-
-	for attempts := int64(0); ; attempts++ {
-		if c.maxRetries != 0 {
-			req.Body = io.NopCloser(bytes.NewBuffer(body))
-		}
-
-		if !skipLoggingPayload {
-			log.Printf("[TRACE] HTTP Request Body: %v", req.Body)
-		}
-
-		resp, err := c.httpClient.Do(req)
-
-		if err != nil {
-			if ok := c.backoff(attempts); !ok {
-				log.Printf("[ERROR] HTTP Connection error occured: %+v", err)
-				log.Printf("[DEBUG] Exit from Do method")
-				return nil, nil, errors.New(fmt.Sprintf("Failed to connect to ND. Verify that you are connecting to an ND.\nError message: %+v", err))
-			} else {
-				log.Printf("[ERROR] HTTP Connection failed: %s, retries: %v", err, attempts)
-				continue
-			}
-		}
-
-		if !skipLoggingPayload {
-			log.Printf("[TRACE] HTTP Response: %d %s %v", resp.StatusCode, resp.Status, resp)
-		} else {
-			log.Printf("[TRACE] HTTP Response: %d %s", resp.StatusCode, resp.Status)
-		}
-
-		bodyBytes, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		bodyStr := string(bodyBytes)
-		err = resp.Body.Close()
-		if err != nil {
-			return nil, nil, err
-		}
-
-		if !skipLoggingPayload {
-			log.Printf("[DEBUG] HTTP response unique string %s %s %s", req.Method, req.URL.String(), bodyStr)
-		}
-
-		if req.Method == "POST" && resp.StatusCode == 200 && bodyStr == "" {
-			log.Printf("[DEBUG] Exit from do method")
-			return nil, resp, nil
-		} else if req.Method != "DELETE" && resp.StatusCode != 204 {
-			obj, err := gabs.ParseJSON(bodyBytes)
-			if err != nil {
-				log.Printf("Error occurred while json parsing %+v", err)
-				return nil, resp, err
-			}
-			log.Printf("[DEBUG] Exit from do method")
-			return obj, resp, err
-		} else if req.Method == "DELETE" && resp.StatusCode == 204 {
-			log.Printf("[DEBUG] Exit from do method")
-			return nil, resp, nil
-		} else if resp.StatusCode == 204 {
-			log.Printf("[DEBUG] Exit from do method")
-			return nil, nil, nil
-		} else {
-			if ok := c.backoff(attempts); !ok {
-				obj, err := gabs.ParseJSON(bodyBytes)
-				if err != nil {
-					log.Printf("[ERROR] Error occured while json parsing: %+v with HTTP StatusCode 405, 500-504", err)
-
-					// If nginx is too busy or the page is not found, ND's nginx will response with an HTML doc instead of a JSON Response.
-					// In those cases, parse the HTML response for the message and return that to the user
-					htmlErr := c.checkHtmlResp(bodyStr)
-					log.Printf("[ERROR] Error occured while json parsing: %s", htmlErr.Error())
-					log.Printf("[DEBUG] Exit from Do method")
-					return nil, resp, errors.New(fmt.Sprintf("Failed to parse JSON response from: %s. Verify that you are connecting to an ND.\nHTTP response status: %s\nMessage: %s", req.URL.String(), resp.Status, htmlErr))
-				}
-				log.Printf("[DEBUG] Exit from Do method")
-				return obj, resp, nil
-			} else {
-				log.Printf("[ERROR] HTTP Request failed: StatusCode %v, Retries: %v", resp.StatusCode, attempts)
-				continue
-			}
-		}
+		diags.AddError(
+			"Creation of rest request failed",
+			fmt.Sprintf("err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
 	}
+	return c.doRequest(ctx, diags, path, restRequest)
 }
 
-// func (c *Client) DoRestRequest(ctx context.Context, diags *diag.Diagnostics, client *Client, path, method string, payload *gabs.Container) *gabs.Container {
-func (c *Client) DoRestRequest(ctx context.Context, diags *diag.Diagnostics, path, method string, payload *gabs.Container) *gabs.Container {
-	if !strings.HasPrefix("/", path) {
+// DoUploadRequest streams the source as the file of the form field with the file field name and the fields in a
+// "multipart/form-data" request, the source is opened again when the request is retried. The progress function, when
+// not nil, is called while the file is sent. The JSON response is returned like DoRestRequest.
+func (c *Client) DoUploadRequest(ctx context.Context, diags *diag.Diagnostics, path, method string, fields map[string]string, fileField string, source nd.UploadSource, progress nd.ProgressFunc) *gabs.Container {
+	if !strings.HasPrefix(path, "/") {
 		path = fmt.Sprintf("/%s", path)
 	}
-	var restRequest *http.Request
-	var err error
 
-	restRequest, err = c.MakeRestRequest(method, path, payload, true, c.skipLoggingPayload)
+	restRequest, err := c.NewMultipartRequest(ctx, method, path, fields, fileField, source, progress)
 	if err != nil {
+		// The creation of the request fails when the source cannot be opened, ie when the file is removed.
 		diags.AddError(
-			"Creation of rest request failed",
-			fmt.Sprintf("err: %s. Please report this issue to the provider developers.", err),
+			fmt.Sprintf("Unable to upload '%s'", source.Name()),
+			fmt.Sprintf("Error: %s", err),
 		)
 		return nil
 	}
+	return c.doRequest(ctx, diags, path, restRequest)
+}
 
-	cont, restResponse, err := c.Do(restRequest, c.skipLoggingPayload)
+func (c *Client) doRequest(ctx context.Context, diags *diag.Diagnostics, path string, restRequest *http.Request) *gabs.Container {
+	method := restRequest.Method
+	var response json.RawMessage
+	_, err := c.Do(restRequest, &response)
 
 	// Return nil when the object is not found and ignore 404 not found error
 	// The resource ID will be set it to nil and the state file content will be deleted when the object is not found
-	if restResponse.StatusCode == 404 {
+	if nd.IsNotFound(err) {
 		return nil
 	}
 
-	if restResponse != nil && cont.Data() != nil && (restResponse.StatusCode != 200 && restResponse.StatusCode != 201) {
+	var apiErr *nd.APIError
+	if errors.As(err, &apiErr) {
 		diags.AddError(
 			fmt.Sprintf("The %s %s rest request failed.", method, path),
-			fmt.Sprintf("Code: %d Response: %s, err: %s. Please report this issue to the provider developers.", restResponse.StatusCode, cont.Data().(map[string]interface{})["errors"], err),
+			fmt.Sprintf("Code: %d Response: %s, err: %s. Please report this issue to the provider developers.", apiErr.StatusCode, apiErr.Messages, err),
 		)
-		tflog.Debug(ctx, fmt.Sprintf("%v", cont.Search("errors")))
+		tflog.Debug(ctx, string(apiErr.Body))
 		return nil
 	} else if err != nil {
 		diags.AddError(
@@ -357,104 +126,52 @@ func (c *Client) DoRestRequest(ctx context.Context, diags *diag.Diagnostics, pat
 		return nil
 	}
 
-	return cont
-}
-
-func (c *Client) backoff(attempts int64) bool {
-	log.Printf("[DEBUG] Begining backoff method: attempts %v on %v", attempts, c.maxRetries)
-	if attempts >= c.maxRetries {
-		log.Printf("[DEBUG] Exit from backoff method with return value false")
-		return false
+	if len(response) == 0 {
+		return nil
 	}
 
-	minDelay := time.Duration(DefaultBackoffMinDelay) * time.Second
-	if c.backoffMinDelay != 0 {
-		minDelay = time.Duration(c.backoffMinDelay) * time.Second
+	cont, err := gabs.ParseJSON(response)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("The %s %s rest request failed.", method, path),
+			fmt.Sprintf("Failed to parse the JSON response, err: %s. Please report this issue to the provider developers.", err),
+		)
+		return nil
 	}
+	return cont
+}
 
-	maxDelay := time.Duration(DefaultBackoffMaxDelay) * time.Second
-	if c.backoffMaxDelay != 0 {
-		maxDelay = time.Duration(c.backoffMaxDelay) * time.Second
+// GetPath returns the API path of the feature for the version of ND, an error is added to the diagnostics when the
+// feature is not supported by the version of ND.
+func (c *Client) GetPath(ctx context.Context, diags *diag.Diagnostics, feature *nd.Feature) string {
+	capability := c.GetCapability(ctx, diags, feature)
+	if capability == nil {
+		return ""
 	}
+	return capability.Path
+}
 
-	factor := DefaultBackoffDelayFactor
-	if c.backoffDelayFactor != 0 {
-		factor = c.backoffDelayFactor
+// GetCapability returns the capability of the feature for the version of ND, an error is added to the diagnostics
+// when the feature is not supported by the version of ND.
+func (c *Client) GetCapability(ctx context.Context, diags *diag.Diagnostics, feature *nd.Feature) *nd.Capability {
+	version, err := c.PlatformVersion(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to detect the version of ND",
+			fmt.Sprintf("Err: %s. Verify that you are connecting to an ND.", err),
+		)
+		return nil
 	}
 
-	min := float64(minDelay)
-	backoff := min * math.Pow(factor, float64(attempts))
-	if backoff > float64(maxDelay) {
-		backoff = float64(maxDelay)
+	capability, err := feature.Capability(version)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("%s is not supported by the version of ND", feature.Name),
+			fmt.Sprintf("%s.", err),
+		)
+		return nil
 	}
-	backoff = (rand.Float64()/2+0.5)*(backoff-min) + min
-	backoffDuration := time.Duration(backoff)
-	log.Printf("[TRACE] Starting sleeping for %v", backoffDuration.Round(time.Second))
-	time.Sleep(backoffDuration)
-	log.Printf("[DEBUG] Exit from backoff method with return value true")
-	return true
-}
 
-// If nginx is too busy or the page is not found, ND's nginx will response with an HTML doc instead of a JSON Response.
-// In those cases, parse the HTML response for the message and return that to the user
-//
-// Sample Response Body: https://github.com/nginx/nginx-releases/blob/master/html/50x.html
-// <!DOCTYPE html>
-// <html>
-// <head>
-// <title>Error</title>
-// <style>
-//
-//	body {
-//	    width: 35em;
-//	    margin: 0 auto;
-//	    font-family: Tahoma, Verdana, Arial, sans-serif;
-//	}
-//
-// </style>
-// </head>
-// <body>
-// <h1>An error occurred.</h1>
-// <p>Sorry, the page you are looking for is currently unavailable.<br/>
-// Please try again later.</p>
-// <p>If you are the system administrator of this resource then you should check
-// the <a href="http://nginx.org/r/error_log">error log</a> for details.</p>
-// <p><em>Faithfully yours, nginx.</em></p>
-// </body>
-// </html>
-//
-// Sample return error:
-// An error occurred. Sorry, the page you are looking for is currently unavailable. If you are the system administrator of this
-// resource then you should check the error log for details. Faithfully yours, nginx.
-func (c *Client) checkHtmlResp(body string) error {
-	reader := strings.NewReader(body)
-	tokenizer := html.NewTokenizer(reader)
-	errStr := ""
-	prevTag := ""
-	for {
-		tt := tokenizer.Next()
-		if tt == html.ErrorToken {
-			break
-		}
-		tag, _ := tokenizer.TagName()
-		token := tokenizer.Token()
-
-		if prevTag == "a" || prevTag == "p" || prevTag == "body" {
-			data := strings.TrimSpace(token.Data)
-			if data == "" {
-				continue
-			}
-			if errStr == "" {
-				errStr = data
-			} else {
-				errStr = errStr + " " + data
-			}
-		}
-		prevTag = string(tag)
-	}
-	if errStr == "" {
-		errStr = "Empty ND HTML Response"
-	}
-	log.Printf("[DEBUG] HTML Error Parsing Result: %s", errStr)
-	return fmt.Errorf(errStr)
+	tflog.Debug(ctx, fmt.Sprintf("Using the API path '%s' of the feature '%s' for ND version %s", capability.Path, feature.Name, version))
+	return capability
 }
@@ -1,74 +1,129 @@
 package client
 
 import (
-	"net/url"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
-var TestBaseUrls = [...]string{
-	"https://nd.host.cisco",
-	"https://nd.host.cisco/",
-	"https://nd.host.cisco//",
-	"https://nd.host.cisco/test",
-	"https://nd.host.cisco/test/",
-	"https://nd.host.cisco/test//",
-}
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			fmt.Fprint(w, `{"token": "token"}`)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
 
-func AssertFullUrl(t *testing.T, baseUrl string, method string, path string, expected string) {
-	url, err := url.Parse(baseUrl)
+	ndClient, err := nd.NewClient(server.URL, nd.WithCredentials("admin", "password"), nd.WithHTTPClient(server.Client()), nd.WithMaxRetries(0))
 	if err != nil {
 		t.Fatal(err)
 	}
-	ndclient := &Client{
-		baseURL: url,
+	return &Client{Client: ndClient}
+}
+
+func TestDoRestRequest(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/objects/test":
+			fmt.Fprint(w, `{"spec": {"name": "test"}}`)
+		case "/api/v1/objects":
+			w.WriteHeader(http.StatusOK)
+		case "/api/v1/objects/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/api/v1/objects/invalid":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errors": ["Invalid name"]}`)
+		}
+	})
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	response := client.DoRestRequest(ctx, &diags, "api/v1/objects/test", "GET", nil)
+	if diags.HasError() || response.Path("spec.name").Data() != "test" {
+		t.Errorf("DoRestRequest() = %v, %v, expected the object", response, diags)
 	}
 
-	actual, err := ndclient.makeFullUrl(method, path)
-	if actual != expected || err != nil {
-		t.Errorf(`makeFullUrl("%s", "%s") = %q, %v, expected %#q`, method, path, actual, err, expected)
+	payload := gabs.New()
+	payload.SetP("test", "spec.name")
+	response = client.DoRestRequest(ctx, &diags, "/api/v1/objects", "POST", payload)
+	if diags.HasError() || response.Data() != nil {
+		t.Errorf("DoRestRequest() = %v, %v, expected an empty response", response, diags)
 	}
-}
 
-func TestMakeFullUrl_Login(t *testing.T) {
-	expected := "https://nd.host.cisco/login"
-	paths := [...]string{
-		"login",
-		"/login",
-		"///login",
+	response = client.DoRestRequest(ctx, &diags, "/api/v1/objects/missing", "GET", nil)
+	if diags.HasError() || response != nil {
+		t.Errorf("DoRestRequest() = %v, %v, expected a nil response without errors when the object is not found", response, diags)
 	}
-	for _, baseUrl := range TestBaseUrls {
-		for _, path := range paths {
-			AssertFullUrl(t, baseUrl, "GET", path, expected)
-		}
+
+	response = client.DoRestRequest(ctx, &diags, "/api/v1/objects/invalid", "POST", payload)
+	if !diags.HasError() || response != nil || !strings.Contains(diags.Errors()[0].Detail(), "Invalid name") {
+		t.Errorf("DoRestRequest() = %v, %v, expected an error with the ND message", response, diags)
 	}
 }
 
-func TestMakeFullUrl_Get(t *testing.T) {
-	expected_nd := "https://nd.host.cisco/nexus/api/sitemanagement/v4/sites"
-	paths := [...]string{
-		"nexus/api/sitemanagement/v4/sites",
-		"/nexus/api/sitemanagement/v4/sites",
-		"///nexus/api/sitemanagement/v4/sites",
-	}
-	for _, baseUrl := range TestBaseUrls {
-		for _, path := range paths {
-			AssertFullUrl(t, baseUrl, "GET", path, expected_nd)
+func TestDoUploadRequest(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
 		}
+		defer file.Close()
+		content, _ := io.ReadAll(file)
+		fmt.Fprintf(w, `{"name": "%s", "fileName": "%s", "content": "%s"}`, r.FormValue("name"), header.Filename, content)
+	})
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	response := client.DoUploadRequest(ctx, &diags, "api/v1/files", "POST", map[string]string{"name": "test"}, "file", nd.NewBytesSource("test.pem", []byte("content")), nil)
+	if diags.HasError() || response.Path("name").Data() != "test" || response.Path("fileName").Data() != "test.pem" || response.Path("content").Data() != "content" {
+		t.Errorf("DoUploadRequest() = %v, %v, expected the uploaded file", response, diags)
 	}
 }
 
-func TestMakeFullUrl_Patch(t *testing.T) {
-	expected := "https://nd.host.cisco/nexus/api/sitemanagement/v4/sites?validate=false"
-	path := "/nexus/api/sitemanagement/v4/sites"
-	for _, baseUrl := range TestBaseUrls {
-		AssertFullUrl(t, baseUrl, "PATCH", path, expected)
+func TestGetPath(t *testing.T) {
+	feature := &nd.Feature{
+		Name: "Test objects",
+		Capabilities: []nd.Capability{
+			{Maximum: "4.1", Path: "/nexus/infra/api/v4/testobjects"},
+			{Minimum: "4.1", Path: "/api/v1/infra/testObjects"},
+		},
+	}
+
+	tests := []struct {
+		version string
+		path    string
+	}{
+		{`{"major": 3, "minor": 2, "maintenance": 1, "patch": "e"}`, "/nexus/infra/api/v4/testobjects"},
+		{`{"major": 4, "minor": 1, "maintenance": 1, "patch": "g"}`, "/api/v1/infra/testObjects"},
+	}
+	for _, test := range tests {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, test.version)
+		})
+		var diags diag.Diagnostics
+		if path := client.GetPath(context.Background(), &diags, feature); diags.HasError() || path != test.path {
+			t.Errorf("GetPath() = %s, %v, expected %s", path, diags, test.path)
+		}
 	}
-}
 
-func TestMakeFullUrl_PatchExtraQuery(t *testing.T) {
-	expected := "https://nd.host.cisco/nexus/api/sitemanagement/v4/sites?extra=query&validate=false"
-	path := "nexus/api/sitemanagement/v4/sites?extra=query"
-	for _, baseUrl := range TestBaseUrls {
-		AssertFullUrl(t, baseUrl, "PATCH", path, expected)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"major": 3, "minor": 2, "maintenance": 1, "patch": "e"}`)
+	})
+	var diags diag.Diagnostics
+	path := client.GetPath(context.Background(), &diags, nd.FeatureServices)
+	if !diags.HasError() || path != "" || diags.Errors()[0].Detail() != "Services requires ND >= 4.1, the version of ND is 3.2.1e." {
+		t.Errorf("GetPath() = %s, %v, expected a requires ND >= 4.1 error", path, diags)
 	}
 }
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultBackupTimeout = 1 * time.Hour
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &BackupAction{}
+var _ action.ActionWithConfigure = &BackupAction{}
+
+func NewBackupAction() action.Action {
+	return &BackupAction{}
+}
+
+// BackupAction defines the action implementation.
+type BackupAction struct {
+	client *client.Client
+}
+
+// BackupActionModel describes the action data model.
+type BackupActionModel struct {
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Type           types.String `tfsdk:"type"`
+	EncryptionKey  types.String `tfsdk:"encryption_key"`
+	RemoteLocation types.String `tfsdk:"remote_location"`
+	Timeout        types.String `tfsdk:"timeout"`
+}
+
+func (a *BackupAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of action: nd_backup")
+	resp.TypeName = req.ProviderTypeName + "_backup"
+	tflog.Debug(ctx, "End metadata of action: nd_backup")
+}
+
+func (a *BackupAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of action: nd_backup")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Triggers an on-demand configuration backup of Nexus Dashboard and waits until the ND task is done.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the backup.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The description of the backup.",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The type of the backup, 'config' only backs up the configuration and 'full' also backs up the operational data. Defaults to 'config'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("config", "full"),
+				},
+			},
+			"encryption_key": schema.StringAttribute{
+				Required:            true,
+				WriteOnly:           true,
+				MarkdownDescription: "The key used to encrypt the backup, the key is required to restore the backup. The key is write-only and never stored by Terraform.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+				},
+			},
+			"remote_location": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the remote location where the backup is stored, the backup is stored on ND when it is not provided.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum duration to wait for the ND task, ie '2h'. Defaults to '1h'.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of action: nd_backup")
+}
+
+func (a *BackupAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of action: nd_backup")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+	tflog.Debug(ctx, "End configure of action: nd_backup")
+}
+
+func (a *BackupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "Start invoke of action: nd_backup")
+	var data BackupActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	timeout := getTimeout(&resp.Diagnostics, data.Timeout, defaultBackupTimeout)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	backupType := data.Type.ValueString()
+	if backupType == "" {
+		backupType = "config"
+	}
+
+	task, err := a.client.Backups.Create(ctx, &nd.Backup{
+		Name:           data.Name.ValueString(),
+		Description:    data.Description.ValueString(),
+		Type:           backupType,
+		EncryptionKey:  data.EncryptionKey.ValueString(),
+		RemoteLocation: data.RemoteLocation.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to start the backup",
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	waitForTask(ctx, &resp.Diagnostics, a.client, task, fmt.Sprintf("Backup '%s'", data.Name.ValueString()), resp.SendProgress)
+	tflog.Debug(ctx, fmt.Sprintf("End invoke of action nd_backup with name '%s'", data.Name.ValueString()))
+}
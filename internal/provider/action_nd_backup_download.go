@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &BackupDownloadAction{}
+var _ action.ActionWithConfigure = &BackupDownloadAction{}
+
+func NewBackupDownloadAction() action.Action {
+	return &BackupDownloadAction{}
+}
+
+// BackupDownloadAction defines the action implementation.
+type BackupDownloadAction struct {
+	client *client.Client
+}
+
+// BackupDownloadActionModel describes the action data model.
+type BackupDownloadActionModel struct {
+	BackupName  types.String `tfsdk:"backup_name"`
+	Destination types.String `tfsdk:"destination"`
+	Checksum    types.String `tfsdk:"checksum"`
+	Overwrite   types.Bool   `tfsdk:"overwrite"`
+	Timeout     types.String `tfsdk:"timeout"`
+}
+
+func (a *BackupDownloadAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of action: nd_backup_download")
+	resp.TypeName = req.ProviderTypeName + "_backup_download"
+	tflog.Debug(ctx, "End metadata of action: nd_backup_download")
+}
+
+func (a *BackupDownloadAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of action: nd_backup_download")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Downloads the file of a configuration backup stored on Nexus Dashboard to a local path.",
+
+		Attributes: map[string]schema.Attribute{
+			"backup_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the backup to download.",
+			},
+			"destination": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The local path where the backup file is saved. The file is written with a '.part' suffix until the download is complete, an interrupted download is resumed by the next invocation.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The hex encoded SHA-256 checksum of the backup file. The downloaded file is verified against the checksum, or against the checksum of the backup returned by ND when it is not provided.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9a-f]{64}$`), "must be a lower case hex encoded SHA-256 checksum"),
+				},
+			},
+			"overwrite": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Replace the file at the destination when it exists. Defaults to false, the action fails when the destination exists.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum duration of the download, ie '2h'. Defaults to '1h'.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of action: nd_backup_download")
+}
+
+func (a *BackupDownloadAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of action: nd_backup_download")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+	tflog.Debug(ctx, "End configure of action: nd_backup_download")
+}
+
+func (a *BackupDownloadAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "Start invoke of action: nd_backup_download")
+	var data BackupDownloadActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	timeout := getTimeout(&resp.Diagnostics, data.Timeout, defaultBackupTimeout)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupName, destination := data.BackupName.ValueString(), data.Destination.ValueString()
+	if _, err := os.Stat(destination); err == nil && !data.Overwrite.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("destination"),
+			"Destination already exists",
+			fmt.Sprintf("The file '%s' already exists. Set overwrite to true to replace the file.", destination),
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	progress := getTransferProgress(ctx, fmt.Sprintf("Download of backup '%s'", backupName), resp.SendProgress)
+	download, err := a.client.Backups.Download(ctx, backupName, destination, data.Checksum.ValueString(), progress)
+	if err != nil {
+		detail := fmt.Sprintf("Error: %s", err)
+		if errors.Is(err, nd.ErrChecksumMismatch) {
+			detail = fmt.Sprintf("%s. The downloaded file was removed.", detail)
+		} else if _, statErr := os.Stat(destination + nd.PartialDownloadSuffix); statErr == nil {
+			detail = fmt.Sprintf("%s. The received data is kept in '%s%s' and the download is resumed by the next invocation.", detail, destination, nd.PartialDownloadSuffix)
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to download the backup '%s'", backupName), detail)
+		return
+	}
+
+	message := fmt.Sprintf("Backup '%s' saved to '%s' (%d bytes, SHA-256 %s)", backupName, download.Path, download.Size, download.Checksum)
+	if download.Resumed {
+		message = fmt.Sprintf("%s, resumed from a previous download", message)
+	}
+	tflog.Info(ctx, message)
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End invoke of action nd_backup_download with backup name '%s'", backupName))
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBackupDownloadAction(t *testing.T) {
+	content := "nd configuration backup"
+	sum := sha256.Sum256([]byte(content))
+	checksum := hex.EncodeToString(sum[:])
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/backups/daily":
+			fmt.Fprintf(w, `{"name": "daily", "sha256": "%s"}`, checksum)
+		case "/api/v1/infra/backups/daily/download":
+			http.ServeContent(w, r, "daily.tgz", time.Time{}, strings.NewReader(content))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	destination := filepath.Join(t.TempDir(), "daily.tgz")
+
+	resp, messages := runTestAction(t, &BackupDownloadAction{}, client, &BackupDownloadActionModel{
+		BackupName:  types.StringValue("daily"),
+		Destination: types.StringValue(destination),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if saved, err := os.ReadFile(destination); err != nil || string(saved) != content {
+		t.Errorf("saved file = %q, %v, expected the backup file", saved, err)
+	}
+	expected := []string{
+		"Download of backup 'daily': 100% (23 of 23 bytes)",
+		fmt.Sprintf("Backup 'daily' saved to '%s' (23 bytes, SHA-256 %s)", destination, checksum),
+	}
+	if strings.Join(messages, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("progress messages = %q, expected %q", messages, expected)
+	}
+
+	// The existing file is only replaced when overwrite is set.
+	resp, _ = runTestAction(t, &BackupDownloadAction{}, client, &BackupDownloadActionModel{
+		BackupName:  types.StringValue("daily"),
+		Destination: types.StringValue(destination),
+	})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Destination already exists" {
+		t.Errorf("expected an error for the existing destination, got %v", resp.Diagnostics)
+	}
+
+	resp, _ = runTestAction(t, &BackupDownloadAction{}, client, &BackupDownloadActionModel{
+		BackupName:  types.StringValue("daily"),
+		Destination: types.StringValue(destination),
+		Checksum:    types.StringValue(strings.Repeat("0", 64)),
+		Overwrite:   types.BoolValue(true),
+	})
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "The downloaded file was removed.") {
+		t.Errorf("expected a checksum mismatch error, got %v", resp.Diagnostics)
+	}
+}
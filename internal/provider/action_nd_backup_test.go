@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBackupAction(t *testing.T) {
+	var backup nd.Backup
+	handler := newTestTaskHandler(t, "POST", "/api/v1/infra/backups",
+		nd.Task{Status: "running", Progress: 50},
+		nd.Task{Status: "completed", Progress: 100, Message: "Backup stored"},
+	)
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			json.NewDecoder(r.Body).Decode(&backup)
+		}
+		handler(w, r)
+	})
+
+	resp, messages := runTestAction(t, &BackupAction{}, client, &BackupActionModel{
+		Name:           types.StringValue("daily"),
+		EncryptionKey:  types.StringValue("Secret123!"),
+		RemoteLocation: types.StringValue("sftp"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if backup.Name != "daily" || backup.Type != "config" || backup.EncryptionKey != "Secret123!" || backup.RemoteLocation != "sftp" {
+		t.Errorf("unexpected backup payload %+v", backup)
+	}
+	expected := []string{
+		"Backup 'daily': running (50%)",
+		"Backup 'daily': completed (100%): Backup stored",
+	}
+	if strings.Join(messages, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("progress messages = %q, expected %q", messages, expected)
+	}
+}
+
+func TestBackupActionWithoutTask(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	resp, messages := runTestAction(t, &BackupAction{}, client, &BackupActionModel{
+		Name:          types.StringValue("daily"),
+		EncryptionKey: types.StringValue("Secret123!"),
+	})
+	if resp.Diagnostics.HasError() || len(resp.Diagnostics.Warnings()) != 1 {
+		t.Errorf("expected a warning when ND does not return a task, got %v", resp.Diagnostics)
+	}
+	if len(messages) != 1 {
+		t.Errorf("progress messages = %q, expected the start of the backup", messages)
+	}
+}
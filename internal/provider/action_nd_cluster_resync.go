@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultClusterResyncTimeout = 30 * time.Minute
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &ClusterResyncAction{}
+var _ action.ActionWithConfigure = &ClusterResyncAction{}
+
+func NewClusterResyncAction() action.Action {
+	return &ClusterResyncAction{}
+}
+
+// ClusterResyncAction defines the action implementation.
+type ClusterResyncAction struct {
+	client *client.Client
+}
+
+// ClusterResyncActionModel describes the action data model.
+type ClusterResyncActionModel struct {
+	FabricName types.String `tfsdk:"fabric_name"`
+	Operation  types.String `tfsdk:"operation"`
+	Timeout    types.String `tfsdk:"timeout"`
+}
+
+func (a *ClusterResyncAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of action: nd_cluster_resync")
+	resp.TypeName = req.ProviderTypeName + "_cluster_resync"
+	tflog.Debug(ctx, "End metadata of action: nd_cluster_resync")
+}
+
+func (a *ClusterResyncAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of action: nd_cluster_resync")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Re-syncs or refreshes a cluster connected to Nexus Dashboard and waits until the ND task is done.",
+
+		Attributes: map[string]schema.Attribute{
+			"fabric_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the cluster.",
+			},
+			"operation": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The operation executed on the cluster, 'resync' re-synchronizes the configuration and inventory of the cluster and 'refresh' only refreshes the connectivity and status of the cluster. Defaults to 'resync'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("resync", "refresh"),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum duration to wait for the ND task, ie '1h'. Defaults to '30m'.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of action: nd_cluster_resync")
+}
+
+func (a *ClusterResyncAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of action: nd_cluster_resync")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+	tflog.Debug(ctx, "End configure of action: nd_cluster_resync")
+}
+
+func (a *ClusterResyncAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "Start invoke of action: nd_cluster_resync")
+	var data ClusterResyncActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	timeout := getTimeout(&resp.Diagnostics, data.Timeout, defaultClusterResyncTimeout)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	fabricName := data.FabricName.ValueString()
+	operation := data.Operation.ValueString()
+	if operation == "" {
+		operation = "resync"
+	}
+
+	var task *nd.Task
+	var err error
+	if operation == "refresh" {
+		task, err = a.client.Clusters.Refresh(ctx, fabricName)
+	} else {
+		task, err = a.client.Clusters.Resync(ctx, fabricName)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to start the %s of cluster '%s'", operation, fabricName),
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	waitForTask(ctx, &resp.Diagnostics, a.client, task, fmt.Sprintf("The %s of cluster '%s'", operation, fabricName), resp.SendProgress)
+	tflog.Debug(ctx, fmt.Sprintf("End invoke of action nd_cluster_resync for cluster '%s'", fabricName))
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newTestTaskHandler returns a handler which starts a task on a POST request to the path and returns the statuses of the task in order.
+func newTestTaskHandler(t *testing.T, method, path string, statuses ...nd.Task) http.HandlerFunc {
+	t.Helper()
+	requests := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == method && r.URL.Path == path:
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"id": "task1", "status": "pending"}`)
+		case r.Method == "GET" && r.URL.Path == "/api/v1/infra/tasks/task1":
+			task := statuses[min(requests, len(statuses)-1)]
+			task.Id = "task1"
+			requests++
+			json.NewEncoder(w).Encode(task)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors": ["Not found"]}`)
+		}
+	}
+}
+
+func runTestAction(t *testing.T, a action.ActionWithConfigure, providerData interface{}, value interface{}) (*action.InvokeResponse, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	configureResp := &action.ConfigureResponse{}
+	a.Configure(ctx, action.ConfigureRequest{ProviderData: providerData}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unable to configure the action: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+
+	messages := []string{}
+	resp := &action.InvokeResponse{SendProgress: func(event action.InvokeProgressEvent) {
+		messages = append(messages, event.Message)
+	}}
+	a.Invoke(ctx, action.InvokeRequest{Config: newTestConfig(t, tfsdk.State{Schema: schemaResp.Schema}, value)}, resp)
+	return resp, messages
+}
+
+func TestClusterResyncAction(t *testing.T) {
+	client := newTestProviderClient(t, newTestTaskHandler(t, "POST", "/api/v1/infra/clusters/apic1/resync",
+		nd.Task{Status: "running", Progress: 30, Message: "Collecting the inventory"},
+		nd.Task{Status: "running", Progress: 60, Message: "Collecting the configuration"},
+		nd.Task{Status: "completed", Progress: 100},
+	))
+
+	resp, messages := runTestAction(t, &ClusterResyncAction{}, client, &ClusterResyncActionModel{FabricName: types.StringValue("apic1")})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	expected := []string{
+		"The resync of cluster 'apic1': running (30%): Collecting the inventory",
+		"The resync of cluster 'apic1': running (60%): Collecting the configuration",
+		"The resync of cluster 'apic1': completed (100%)",
+	}
+	if strings.Join(messages, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("progress messages = %q, expected %q", messages, expected)
+	}
+}
+
+func TestClusterResyncActionRefreshFailed(t *testing.T) {
+	client := newTestProviderClient(t, newTestTaskHandler(t, "POST", "/api/v1/infra/clusters/apic1/refresh",
+		nd.Task{Status: "failed", Message: "Cluster unreachable"},
+	))
+
+	resp, messages := runTestAction(t, &ClusterResyncAction{}, client, &ClusterResyncActionModel{FabricName: types.StringValue("apic1"), Operation: types.StringValue("refresh")})
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "Cluster unreachable") {
+		t.Errorf("expected an error with the task message, got %v", resp.Diagnostics)
+	}
+	if len(messages) != 1 || messages[0] != "The refresh of cluster 'apic1': failed: Cluster unreachable" {
+		t.Errorf("progress messages = %q", messages)
+	}
+}
+
+func TestClusterResyncActionErrors(t *testing.T) {
+	client := newTestProviderClient(t, newTestTaskHandler(t, "POST", "/api/v1/infra/clusters/apic1/resync", nd.Task{Status: "completed"}))
+
+	resp, _ := runTestAction(t, &ClusterResyncAction{}, client, &ClusterResyncActionModel{FabricName: types.StringValue("missing")})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Unable to start the resync of cluster 'missing'" {
+		t.Errorf("expected an error for a missing cluster, got %v", resp.Diagnostics)
+	}
+
+	resp, _ = runTestAction(t, &ClusterResyncAction{}, client, &ClusterResyncActionModel{FabricName: types.StringValue("apic1"), Timeout: types.StringValue("soon")})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Invalid timeout" {
+		t.Errorf("expected an error for an invalid timeout, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultRestoreTimeout = 1 * time.Hour
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &RestoreAction{}
+var _ action.ActionWithConfigure = &RestoreAction{}
+var _ action.ActionWithValidateConfig = &RestoreAction{}
+
+func NewRestoreAction() action.Action {
+	return &RestoreAction{}
+}
+
+// RestoreAction defines the action implementation.
+type RestoreAction struct {
+	client *client.Client
+}
+
+// RestoreActionModel describes the action data model.
+type RestoreActionModel struct {
+	BackupName     types.String `tfsdk:"backup_name"`
+	RemoteLocation types.String `tfsdk:"remote_location"`
+	File           types.String `tfsdk:"file"`
+	Mode           types.String `tfsdk:"mode"`
+	EncryptionKey  types.String `tfsdk:"encryption_key"`
+	ConfirmRestore types.Bool   `tfsdk:"confirm_restore"`
+	Timeout        types.String `tfsdk:"timeout"`
+}
+
+func (a *RestoreAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of action: nd_restore")
+	resp.TypeName = req.ProviderTypeName + "_restore"
+	tflog.Debug(ctx, "End metadata of action: nd_restore")
+}
+
+func (a *RestoreAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of action: nd_restore")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Restores a configuration backup of Nexus Dashboard and waits until the ND task is done. The restore is refused unless `confirm_restore` is set to true.",
+
+		Attributes: map[string]schema.Attribute{
+			"backup_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the backup known by ND to restore. Exactly one of `backup_name` or `file` must be provided.",
+			},
+			"remote_location": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the remote location where the backup `file` is stored, required with `file`.",
+			},
+			"file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path of the backup file on the `remote_location` to restore. Exactly one of `backup_name` or `file` must be provided.",
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The mode of the restore, 'merge' merges the configuration of the backup with the current configuration and 'overwrite' replaces the current configuration. Defaults to 'merge'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(nd.RestoreModeMerge, nd.RestoreModeOverwrite),
+				},
+			},
+			"encryption_key": schema.StringAttribute{
+				Required:            true,
+				WriteOnly:           true,
+				MarkdownDescription: "The key used to encrypt the backup. The key is write-only and never stored by Terraform.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+				},
+			},
+			"confirm_restore": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "The confirmation of the restore, the restore is refused unless it is set to true.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum duration to wait for the ND task, ie '2h'. Defaults to '1h'.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of action: nd_restore")
+}
+
+func (a *RestoreAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data RestoreActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateRestoreConfig(&resp.Diagnostics, &data)
+}
+
+func (a *RestoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of action: nd_restore")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+	tflog.Debug(ctx, "End configure of action: nd_restore")
+}
+
+func (a *RestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "Start invoke of action: nd_restore")
+	var data RestoreActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	timeout := getTimeout(&resp.Diagnostics, data.Timeout, defaultRestoreTimeout)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration is validated again because the values unknown during the validation are known when the action is invoked.
+	validateRestoreConfig(&resp.Diagnostics, &data)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	mode := data.Mode.ValueString()
+	if mode == "" {
+		mode = nd.RestoreModeMerge
+	}
+
+	description := fmt.Sprintf("Restore of backup '%s'", data.BackupName.ValueString())
+	if data.BackupName.IsNull() {
+		description = fmt.Sprintf("Restore of file '%s' from remote location '%s'", data.File.ValueString(), data.RemoteLocation.ValueString())
+	}
+
+	task, err := a.client.Backups.Restore(ctx, &nd.Restore{
+		BackupName:     data.BackupName.ValueString(),
+		RemoteLocation: data.RemoteLocation.ValueString(),
+		File:           data.File.ValueString(),
+		Mode:           mode,
+		EncryptionKey:  data.EncryptionKey.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to start the restore",
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	waitForTask(ctx, &resp.Diagnostics, a.client, task, description, resp.SendProgress)
+	tflog.Debug(ctx, fmt.Sprintf("End invoke of action nd_restore with mode '%s'", mode))
+}
+
+// validateRestoreConfig validates the source and the confirmation of the restore, the unknown values are not validated.
+func validateRestoreConfig(diags *diag.Diagnostics, data *RestoreActionModel) {
+	if !data.ConfirmRestore.IsUnknown() && !data.ConfirmRestore.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("confirm_restore"),
+			"Restore not confirmed",
+			"The restore replaces the configuration of ND and is refused unless 'confirm_restore' is set to true.",
+		)
+	}
+
+	if data.BackupName.IsUnknown() || data.File.IsUnknown() {
+		return
+	}
+	if data.BackupName.IsNull() == data.File.IsNull() {
+		diags.AddAttributeError(
+			path.Root("backup_name"),
+			"Invalid restore source",
+			"Exactly one of 'backup_name' or 'file' must be provided.",
+		)
+	}
+	if !data.File.IsNull() && data.RemoteLocation.IsNull() {
+		diags.AddAttributeError(
+			path.Root("remote_location"),
+			"Missing remote_location",
+			"The 'remote_location' attribute is required when the 'file' attribute is provided.",
+		)
+	}
+	if !data.BackupName.IsNull() && !data.RemoteLocation.IsNull() && !data.RemoteLocation.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("remote_location"),
+			"Invalid remote_location",
+			"The 'remote_location' attribute is only used with the 'file' attribute, the location of a backup is known by ND.",
+		)
+	}
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRestoreAction(t *testing.T) {
+	var restore nd.Restore
+	handler := newTestTaskHandler(t, "POST", "/api/v1/infra/restore",
+		nd.Task{Status: "running", Progress: 50, Message: "Restoring the configuration"},
+		nd.Task{Status: "completed", Progress: 100},
+	)
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			json.NewDecoder(r.Body).Decode(&restore)
+		}
+		handler(w, r)
+	})
+
+	resp, messages := runTestAction(t, &RestoreAction{}, client, &RestoreActionModel{
+		RemoteLocation: types.StringValue("sftp"),
+		File:           types.StringValue("/backups/daily.tgz"),
+		EncryptionKey:  types.StringValue("Secret123!"),
+		ConfirmRestore: types.BoolValue(true),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if restore.File != "/backups/daily.tgz" || restore.RemoteLocation != "sftp" || restore.Mode != nd.RestoreModeMerge || restore.EncryptionKey != "Secret123!" {
+		t.Errorf("unexpected restore payload %+v", restore)
+	}
+	expected := []string{
+		"Restore of file '/backups/daily.tgz' from remote location 'sftp': running (50%): Restoring the configuration",
+		"Restore of file '/backups/daily.tgz' from remote location 'sftp': completed (100%)",
+	}
+	if strings.Join(messages, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("progress messages = %q, expected %q", messages, expected)
+	}
+}
+
+func TestRestoreActionFailed(t *testing.T) {
+	client := newTestProviderClient(t, newTestTaskHandler(t, "POST", "/api/v1/infra/restore",
+		nd.Task{Status: "failed", Message: "Restore failed", Errors: []string{"Invalid encryption key", "Backup version 3.2 is not supported"}},
+	))
+
+	resp, _ := runTestAction(t, &RestoreAction{}, client, &RestoreActionModel{
+		BackupName:     types.StringValue("daily"),
+		Mode:           types.StringValue("overwrite"),
+		EncryptionKey:  types.StringValue("Secret123!"),
+		ConfirmRestore: types.BoolValue(true),
+	})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Restore of backup 'daily' failed" {
+		t.Fatalf("expected an error for the failed restore, got %v", resp.Diagnostics)
+	}
+	expected := "Error: task task1 failed: Restore failed\n\nErrors reported by ND:\n  - Invalid encryption key\n  - Backup version 3.2 is not supported"
+	if detail := resp.Diagnostics.Errors()[0].Detail(); detail != expected {
+		t.Errorf("error detail = %q, expected %q", detail, expected)
+	}
+}
+
+func TestRestoreActionRefused(t *testing.T) {
+	requests := 0
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	tests := []struct {
+		data    *RestoreActionModel
+		summary string
+	}{
+		{&RestoreActionModel{BackupName: types.StringValue("daily"), ConfirmRestore: types.BoolValue(false)}, "Restore not confirmed"},
+		{&RestoreActionModel{ConfirmRestore: types.BoolValue(true)}, "Invalid restore source"},
+		{&RestoreActionModel{BackupName: types.StringValue("daily"), File: types.StringValue("/backups/daily.tgz"), RemoteLocation: types.StringValue("sftp"), ConfirmRestore: types.BoolValue(true)}, "Invalid restore source"},
+		{&RestoreActionModel{File: types.StringValue("/backups/daily.tgz"), ConfirmRestore: types.BoolValue(true)}, "Missing remote_location"},
+		{&RestoreActionModel{BackupName: types.StringValue("daily"), RemoteLocation: types.StringValue("sftp"), ConfirmRestore: types.BoolValue(true)}, "Invalid remote_location"},
+	}
+	for _, test := range tests {
+		test.data.EncryptionKey = types.StringValue("Secret123!")
+		resp, _ := runTestAction(t, &RestoreAction{}, client, test.data)
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != test.summary {
+			t.Errorf("expected the error %q for %+v, got %v", test.summary, test.data, resp.Diagnostics)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("expected no request to ND for a refused restore, got %d requests", requests)
+	}
+}
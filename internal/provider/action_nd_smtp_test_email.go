@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultSmtpTestEmailTimeout = 2 * time.Minute
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &SmtpTestEmailAction{}
+var _ action.ActionWithConfigure = &SmtpTestEmailAction{}
+
+func NewSmtpTestEmailAction() action.Action {
+	return &SmtpTestEmailAction{}
+}
+
+// SmtpTestEmailAction defines the action implementation.
+type SmtpTestEmailAction struct {
+	client *client.Client
+}
+
+// SmtpTestEmailActionModel describes the action data model.
+type SmtpTestEmailActionModel struct {
+	Recipient types.String `tfsdk:"recipient"`
+	Timeout   types.String `tfsdk:"timeout"`
+}
+
+func (a *SmtpTestEmailAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of action: nd_smtp_test_email")
+	resp.TypeName = req.ProviderTypeName + "_smtp_test_email"
+	tflog.Debug(ctx, "End metadata of action: nd_smtp_test_email")
+}
+
+func (a *SmtpTestEmailAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of action: nd_smtp_test_email")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Sends a test email with the SMTP configuration of Nexus Dashboard and reports the response of the SMTP server.",
+
+		Attributes: map[string]schema.Attribute{
+			"recipient": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email address of the recipient of the test email.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emailAddressRegex, "must be an email address"),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum duration to wait for the delivery of the test email, ie '5m'. Defaults to '2m'.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of action: nd_smtp_test_email")
+}
+
+func (a *SmtpTestEmailAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of action: nd_smtp_test_email")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+	tflog.Debug(ctx, "End configure of action: nd_smtp_test_email")
+}
+
+func (a *SmtpTestEmailAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "Start invoke of action: nd_smtp_test_email")
+	var data SmtpTestEmailActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	timeout := getTimeout(&resp.Diagnostics, data.Timeout, defaultSmtpTestEmailTimeout)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	recipient := data.Recipient.ValueString()
+	result, err := a.client.Notifications.SendTestEmail(ctx, recipient)
+	if err != nil {
+		// The response of the SMTP server is reported when ND was unable to deliver the test email.
+		var testEmailErr *nd.TestEmailError
+		if errors.As(err, &testEmailErr) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Test email to '%s' failed", recipient),
+				fmt.Sprintf("Error: %s. Verify the SMTP configuration of ND, see the nd_smtp_config resource.", err),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to send the test email to '%s'", recipient),
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	message := fmt.Sprintf("Test email sent to '%s'", recipient)
+	if result.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, result.Message)
+	}
+	tflog.Info(ctx, message)
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End invoke of action nd_smtp_test_email with recipient '%s'", recipient))
+}
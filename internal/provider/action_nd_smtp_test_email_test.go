@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSmtpTestEmailAction(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/infra/clusterConfig/smtp/test" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "noc@example.com") {
+			io.WriteString(w, `{"recipient": "noc@example.com", "success": true, "message": "250 2.0.0 Ok: queued as 4F2A1"}`)
+			return
+		}
+		io.WriteString(w, `{"recipient": "unknown@example.com", "success": false, "message": "550 5.1.1 Recipient address rejected"}`)
+	})
+
+	resp, messages := runTestAction(t, &SmtpTestEmailAction{}, client, &SmtpTestEmailActionModel{Recipient: types.StringValue("noc@example.com")})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if expected := "Test email sent to 'noc@example.com': 250 2.0.0 Ok: queued as 4F2A1"; len(messages) != 1 || messages[0] != expected {
+		t.Errorf("progress messages = %q, expected %q", messages, expected)
+	}
+
+	// The response of the SMTP server is reported in the error of the failed test email.
+	resp, _ = runTestAction(t, &SmtpTestEmailAction{}, client, &SmtpTestEmailActionModel{Recipient: types.StringValue("unknown@example.com")})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Test email to 'unknown@example.com' failed" || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "550 5.1.1 Recipient address rejected") {
+		t.Errorf("expected a failed test email error, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BackupsDataSource{}
+
+func NewBackupsDataSource() datasource.DataSource {
+	return &BackupsDataSource{}
+}
+
+// BackupsDataSource defines the data source implementation.
+type BackupsDataSource struct {
+	client *client.Client
+}
+
+// BackupsDataModel describes the data source data model.
+type BackupsDataModel struct {
+	Backups []BackupDataModel `tfsdk:"backups"`
+}
+
+// BackupDataModel describes a backup of the data source.
+type BackupDataModel struct {
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Type           types.String `tfsdk:"type"`
+	State          types.String `tfsdk:"state"`
+	RemoteLocation types.String `tfsdk:"remote_location"`
+	Size           types.Int64  `tfsdk:"size"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	CompletedAt    types.String `tfsdk:"completed_at"`
+}
+
+func (d *BackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: nd_backups")
+	resp.TypeName = req.ProviderTypeName + "_backups"
+	tflog.Debug(ctx, "End metadata of datasource: nd_backups")
+}
+
+func (d *BackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: nd_backups")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for all Configuration Backups of Nexus Dashboard",
+
+		Attributes: map[string]schema.Attribute{
+			"backups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The on-demand and scheduled backups of Nexus Dashboard ordered by creation time.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the backup.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the backup.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the backup.",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The state of the backup.",
+						},
+						"remote_location": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the remote location where the backup is stored, it is null when the backup is stored on ND.",
+						},
+						"size": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The size of the backup in bytes.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time when the backup was started.",
+						},
+						"completed_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time when the backup was completed, it is null when the backup is not completed.",
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: nd_backups")
+}
+
+func (d *BackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: nd_backups")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: nd_backups")
+}
+
+func (d *BackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: nd_backups")
+
+	backups, err := d.client.Backups.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list the backups",
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	data := BackupsDataModel{Backups: []BackupDataModel{}}
+	for _, backup := range backups {
+		data.Backups = append(data.Backups, getBackupDataModel(backup))
+	}
+	// ND returns the timestamps in RFC 3339 format in UTC, the timestamps are ordered as strings.
+	sort.SliceStable(data.Backups, func(i, j int) bool {
+		if data.Backups[i].CreatedAt.ValueString() != data.Backups[j].CreatedAt.ValueString() {
+			return data.Backups[i].CreatedAt.ValueString() < data.Backups[j].CreatedAt.ValueString()
+		}
+		return data.Backups[i].Name.ValueString() < data.Backups[j].Name.ValueString()
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource nd_backups with %d backups", len(data.Backups)))
+}
+
+func getBackupDataModel(backup nd.Backup) BackupDataModel {
+	data := BackupDataModel{
+		Name:           basetypes.NewStringValue(backup.Name),
+		Description:    basetypes.NewStringValue(backup.Description),
+		Type:           basetypes.NewStringValue(backup.Type),
+		State:          basetypes.NewStringValue(backup.State),
+		RemoteLocation: basetypes.NewStringNull(),
+		Size:           basetypes.NewInt64Value(backup.Size),
+		CreatedAt:      basetypes.NewStringValue(backup.CreatedAt),
+		CompletedAt:    basetypes.NewStringNull(),
+	}
+	if backup.RemoteLocation != "" {
+		data.RemoteLocation = basetypes.NewStringValue(backup.RemoteLocation)
+	}
+	if backup.CompletedAt != "" {
+		data.CompletedAt = basetypes.NewStringValue(backup.CompletedAt)
+	}
+	return data
+}
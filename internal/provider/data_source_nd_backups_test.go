@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceNdBackups(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             testConfigDataSourceNdBackups,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nd_backups.test", "backups.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestBackupDataModel(t *testing.T) {
+	data := getBackupDataModel(nd.Backup{Name: "backup", Type: "config", State: "completed", Size: 1024, CreatedAt: "2030-01-01T02:00:00Z", CompletedAt: "2030-01-01T02:01:00Z"})
+	if data.Size.ValueInt64() != 1024 || data.CompletedAt.ValueString() != "2030-01-01T02:01:00Z" || !data.RemoteLocation.IsNull() {
+		t.Errorf("unexpected size %s, completed at %s and remote location %s", data.Size, data.CompletedAt, data.RemoteLocation)
+	}
+	data = getBackupDataModel(nd.Backup{Name: "backup", State: "inProgress", RemoteLocation: "sftp_location"})
+	if !data.CompletedAt.IsNull() || data.RemoteLocation.ValueString() != "sftp_location" {
+		t.Errorf("unexpected completed at %s and remote location %s", data.CompletedAt, data.RemoteLocation)
+	}
+}
+
+const testConfigDataSourceNdBackups = `
+data "nd_backups" "test" {}
+`
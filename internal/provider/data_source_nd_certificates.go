@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CertificatesDataSource{}
+
+func NewCertificatesDataSource() datasource.DataSource {
+	return &CertificatesDataSource{}
+}
+
+// CertificatesDataSource defines the data source implementation.
+type CertificatesDataSource struct {
+	client *client.Client
+}
+
+// CertificatesDataModel describes the data source data model.
+type CertificatesDataModel struct {
+	Certificates []CertificateDataModel `tfsdk:"certificates"`
+	TrustedCas   []TrustedCaDataModel   `tfsdk:"trusted_cas"`
+}
+
+// CertificateDataModel describes an installed certificate of the data source.
+type CertificateDataModel struct {
+	Name                    types.String `tfsdk:"name"`
+	WebUi                   types.Bool   `tfsdk:"web_ui"`
+	Subject                 types.String `tfsdk:"subject"`
+	Issuer                  types.String `tfsdk:"issuer"`
+	SubjectAlternativeNames types.List   `tfsdk:"subject_alternative_names"`
+	NotBefore               types.String `tfsdk:"not_before"`
+	NotAfter                types.String `tfsdk:"not_after"`
+}
+
+// TrustedCaDataModel describes a CA trust anchor of the data source.
+type TrustedCaDataModel struct {
+	Name      types.String `tfsdk:"name"`
+	Usages    types.Set    `tfsdk:"usages"`
+	Subject   types.String `tfsdk:"subject"`
+	Issuer    types.String `tfsdk:"issuer"`
+	NotBefore types.String `tfsdk:"not_before"`
+	NotAfter  types.String `tfsdk:"not_after"`
+}
+
+func (d *CertificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: nd_certificates")
+	resp.TypeName = req.ProviderTypeName + "_certificates"
+	tflog.Debug(ctx, "End metadata of datasource: nd_certificates")
+}
+
+func (d *CertificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: nd_certificates")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for the installed Certificates and the trusted CA certificates of Nexus Dashboard",
+
+		Attributes: map[string]schema.Attribute{
+			"certificates": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The certificates installed on Nexus Dashboard ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the certificate.",
+						},
+						"web_ui": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the certificate is bound to the web UI and the API of ND.",
+						},
+						"subject": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The subject of the certificate, null when the certificate signing request is not signed yet.",
+						},
+						"issuer": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The issuer of the certificate.",
+						},
+						"subject_alternative_names": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The subject alternative names of the certificate.",
+						},
+						"not_before": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The start of the validity of the certificate in RFC 3339 format.",
+						},
+						"not_after": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The expiry of the certificate in RFC 3339 format.",
+						},
+					},
+				},
+			},
+			"trusted_cas": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The CA certificates trusted by Nexus Dashboard to validate the certificates of its peers ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the CA certificate.",
+						},
+						"usages": schema.SetAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The peers whose certificates are validated with the CA certificate.",
+						},
+						"subject": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The subject of the CA certificate.",
+						},
+						"issuer": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The issuer of the CA certificate.",
+						},
+						"not_before": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The start of the validity of the CA certificate in RFC 3339 format.",
+						},
+						"not_after": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The expiry of the CA certificate in RFC 3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: nd_certificates")
+}
+
+func (d *CertificatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: nd_certificates")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: nd_certificates")
+}
+
+func (d *CertificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: nd_certificates")
+
+	certificatesPath := d.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureCertificates)
+	trustedCasPath := d.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureTrustedCAs)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificatesData := d.client.DoRestRequest(ctx, &resp.Diagnostics, certificatesPath, "GET", nil)
+	trustedCasData := d.client.DoRestRequest(ctx, &resp.Diagnostics, trustedCasPath, "GET", nil)
+	uiCertificate := getUICertificate(ctx, &resp.Diagnostics, d.client)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := CertificatesDataModel{Certificates: []CertificateDataModel{}, TrustedCas: []TrustedCaDataModel{}}
+	for _, item := range getResponseItems(certificatesData) {
+		name := getResponseString(item, "/name")
+		details := getCertificateDetails(ctx, &resp.Diagnostics, getResponseString(item, "/certificate"))
+		data.Certificates = append(data.Certificates, CertificateDataModel{
+			Name:                    basetypes.NewStringValue(name),
+			WebUi:                   basetypes.NewBoolValue(name == uiCertificate),
+			Subject:                 details.Subject,
+			Issuer:                  details.Issuer,
+			SubjectAlternativeNames: details.SubjectAlternativeNames,
+			NotBefore:               details.NotBefore,
+			NotAfter:                details.NotAfter,
+		})
+	}
+	sort.Slice(data.Certificates, func(i, j int) bool {
+		return data.Certificates[i].Name.ValueString() < data.Certificates[j].Name.ValueString()
+	})
+
+	for _, item := range getResponseItems(trustedCasData) {
+		details := getCertificateDetails(ctx, &resp.Diagnostics, getResponseString(item, "/certificate"))
+		usages, setDiags := types.SetValueFrom(ctx, types.StringType, getTrustedCaUsages(getStringElements(getResponseList(item, "/usages"))))
+		resp.Diagnostics.Append(setDiags...)
+		data.TrustedCas = append(data.TrustedCas, TrustedCaDataModel{
+			Name:      basetypes.NewStringValue(getResponseString(item, "/name")),
+			Usages:    usages,
+			Subject:   details.Subject,
+			Issuer:    details.Issuer,
+			NotBefore: details.NotBefore,
+			NotAfter:  details.NotAfter,
+		})
+	}
+	sort.Slice(data.TrustedCas, func(i, j int) bool {
+		return data.TrustedCas[i].Name.ValueString() < data.TrustedCas[j].Name.ValueString()
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource nd_certificates with %d certificates and %d trusted CA certificates", len(data.Certificates), len(data.TrustedCas)))
+}
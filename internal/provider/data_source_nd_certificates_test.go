@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceNdCertificates(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             testConfigResourceNdCertificateCreate + testConfigDataSourceNdCertificates,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.nd_certificates.test", "certificates.*", map[string]string{
+						"name":   "terraform_certificate",
+						"web_ui": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.nd_certificates.test", "certificates.*", map[string]string{
+						"web_ui": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testConfigDataSourceNdCertificates = `
+data "nd_certificates" "test" {
+  depends_on = [nd_certificate.test]
+}
+`
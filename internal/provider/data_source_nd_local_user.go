@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LocalUserDataSource{}
+
+func NewLocalUserDataSource() datasource.DataSource {
+	return &LocalUserDataSource{}
+}
+
+// LocalUserDataSource defines the data source implementation.
+type LocalUserDataSource struct {
+	client *client.Client
+}
+
+// LocalUserDataModel describes the data source data model.
+type LocalUserDataModel struct {
+	Id              types.String `tfsdk:"id"`
+	LoginId         types.String `tfsdk:"login_id"`
+	FirstName       types.String `tfsdk:"first_name"`
+	LastName        types.String `tfsdk:"last_name"`
+	Email           types.String `tfsdk:"email"`
+	AccountStatus   types.String `tfsdk:"account_status"`
+	SecurityDomains types.Set    `tfsdk:"security_domains"`
+}
+
+// getLocalUserDataSourceAttributes returns the computed attributes of a local user, the login_id is only required when
+// the attributes are used by the singular data source.
+func getLocalUserDataSourceAttributes(loginIdRequired bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the local user.",
+		},
+		"login_id": schema.StringAttribute{
+			Required:            loginIdRequired,
+			Computed:            !loginIdRequired,
+			MarkdownDescription: "The login ID of the local user.",
+		},
+		"first_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The first name of the local user.",
+		},
+		"last_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The last name of the local user.",
+		},
+		"email": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The email address of the local user.",
+		},
+		"account_status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The status of the local user account.",
+		},
+		"security_domains": schema.SetNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The roles of the local user in each security domain.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The name of the security domain.",
+					},
+					"roles": schema.SetAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "The names of the roles of the local user in the security domain.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LocalUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: nd_local_user")
+	resp.TypeName = req.ProviderTypeName + "_local_user"
+	tflog.Debug(ctx, "End metadata of datasource: nd_local_user")
+}
+
+func (d *LocalUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: nd_local_user")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for Local Users for Nexus Dashboard",
+		Attributes:          getLocalUserDataSourceAttributes(true),
+	}
+	tflog.Debug(ctx, "End schema of datasource: nd_local_user")
+}
+
+func (d *LocalUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: nd_local_user")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: nd_local_user")
+}
+
+func (d *LocalUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: nd_local_user")
+	var data *LocalUserDataModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource nd_local_user with id '%s'", data.LoginId.ValueString()))
+
+	apiPath := d.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureLocalUsers)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData := d.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, data.LoginId.ValueString()), "GET", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		resp.Diagnostics.AddError("Failed to read nd_local_user data source", fmt.Sprintf("The local user '%s' was not found.", data.LoginId.ValueString()))
+		return
+	}
+	*data = getLocalUserDataModel(ctx, &resp.Diagnostics, responseData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource nd_local_user with id '%s'", data.Id.ValueString()))
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceNdLocalUser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             testConfigResourceNdLocalUserCreate + testConfigDataSourceNdLocalUser,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nd_local_user.test", "id", "terraform_user"),
+					resource.TestCheckResourceAttr("data.nd_local_user.test", "login_id", "terraform_user"),
+					resource.TestCheckResourceAttr("data.nd_local_user.test", "account_status", "active"),
+					resource.TestCheckResourceAttr("data.nd_local_user.test", "security_domains.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.nd_local_user.test", "security_domains.*", map[string]string{"name": "all", "roles.#": "1", "roles.0": "observer"}),
+				),
+			},
+		},
+	})
+}
+
+const testConfigDataSourceNdLocalUser = `
+data "nd_local_user" "test" {
+  login_id = nd_local_user.test.login_id
+}
+`
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LocalUsersDataSource{}
+
+func NewLocalUsersDataSource() datasource.DataSource {
+	return &LocalUsersDataSource{}
+}
+
+// LocalUsersDataSource defines the data source implementation.
+type LocalUsersDataSource struct {
+	client *client.Client
+}
+
+// LocalUsersDataModel describes the data source data model.
+type LocalUsersDataModel struct {
+	LocalUsers []LocalUserDataModel `tfsdk:"local_users"`
+}
+
+func (d *LocalUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: nd_local_users")
+	resp.TypeName = req.ProviderTypeName + "_local_users"
+	tflog.Debug(ctx, "End metadata of datasource: nd_local_users")
+}
+
+func (d *LocalUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: nd_local_users")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for all Local Users of Nexus Dashboard",
+
+		Attributes: map[string]schema.Attribute{
+			"local_users": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The local users of Nexus Dashboard ordered by login ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: getLocalUserDataSourceAttributes(false),
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: nd_local_users")
+}
+
+func (d *LocalUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: nd_local_users")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: nd_local_users")
+}
+
+func (d *LocalUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: nd_local_users")
+
+	apiPath := d.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureLocalUsers)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData := d.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "GET", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := LocalUsersDataModel{LocalUsers: []LocalUserDataModel{}}
+	for _, item := range getResponseItems(responseData) {
+		data.LocalUsers = append(data.LocalUsers, getLocalUserDataModel(ctx, &resp.Diagnostics, item))
+	}
+	sortLocalUsers(data.LocalUsers)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource nd_local_users with %d local users", len(data.LocalUsers)))
+}
+
+func sortLocalUsers(localUsers []LocalUserDataModel) {
+	sort.Slice(localUsers, func(i, j int) bool {
+		return localUsers[i].LoginId.ValueString() < localUsers[j].LoginId.ValueString()
+	})
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceNdLocalUsers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             testConfigResourceNdLocalUserCreate + testConfigDataSourceNdLocalUsers,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.nd_local_users.test", "local_users.*", map[string]string{
+						"id":             "terraform_user",
+						"login_id":       "terraform_user",
+						"account_status": "active",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.nd_local_users.test", "local_users.*", map[string]string{
+						"login_id": "admin",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testConfigDataSourceNdLocalUsers = `
+data "nd_local_users" "test" {
+  depends_on = [nd_local_user.test]
+}
+`
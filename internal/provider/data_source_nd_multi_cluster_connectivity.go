@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -161,6 +162,10 @@ func (d *ClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 }
 
 func getAndSetDataClusterAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *ClusterDataModel) {
+	clusterPath := client.GetPath(ctx, diags, nd.FeatureClusters)
+	if diags.HasError() {
+		return
+	}
 	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", clusterPath, data.Id.ValueString()), "GET", nil)
 
 	if responseData.Data() != nil {
@@ -202,17 +207,7 @@ func getAndSetDataClusterAttributes(ctx context.Context, diags *diag.Diagnostics
 				if telemetryValueMap["network"] != nil {
 					data.TelemetryNetwork = basetypes.NewStringValue(telemetryValueMap["network"].(string))
 				}
-				epgDn := telemetryValueMap["epg"].(string)
-				epgName := ""
-				if epgDn != "" {
-					epgSeparator := "/inb-"
-					lastIndex := strings.LastIndex(epgDn, epgSeparator)
-					if lastIndex != -1 {
-						epgNameStartIndex := lastIndex + len(epgSeparator)
-						epgName = epgDn[epgNameStartIndex:]
-					}
-				}
-				data.InbandEpg = basetypes.NewStringValue(epgName)
+				data.InbandEpg = basetypes.NewStringValue(getInbandEpgName(telemetryValueMap["epg"].(string)))
 
 				featuresList := []string{}
 				if telemetryValueMap["status"].(string) != "" && telemetryValueMap["status"].(string) == "enabled" {
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RolesDataSource{}
+
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+// RolesDataSource defines the data source implementation.
+type RolesDataSource struct {
+	client *client.Client
+}
+
+// RolesDataModel describes the data source data model.
+type RolesDataModel struct {
+	Roles []RoleDataModel `tfsdk:"roles"`
+}
+
+// RoleDataModel describes a role of the data source.
+type RoleDataModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	BuiltIn     types.Bool   `tfsdk:"built_in"`
+	Privileges  types.Set    `tfsdk:"privileges"`
+}
+
+func (d *RolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: nd_roles")
+	resp.TypeName = req.ProviderTypeName + "_roles"
+	tflog.Debug(ctx, "End metadata of datasource: nd_roles")
+}
+
+func (d *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: nd_roles")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for all Roles of Nexus Dashboard",
+
+		Attributes: map[string]schema.Attribute{
+			"roles": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The built-in and custom roles of Nexus Dashboard ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the role.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the role.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the role.",
+						},
+						"built_in": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the role is built into ND, the built-in roles cannot be modified.",
+						},
+						"privileges": schema.SetNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The privileges of the role with their access levels.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The name of the privilege.",
+									},
+									"access": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The access level of the role to the privilege.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: nd_roles")
+}
+
+func (d *RolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: nd_roles")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: nd_roles")
+}
+
+func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: nd_roles")
+
+	apiPath := d.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureRoles)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData := d.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "GET", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := RolesDataModel{Roles: []RoleDataModel{}}
+	for _, item := range getResponseItems(responseData) {
+		data.Roles = append(data.Roles, getRoleDataModel(ctx, &resp.Diagnostics, item))
+	}
+	sort.Slice(data.Roles, func(i, j int) bool {
+		return data.Roles[i].Name.ValueString() < data.Roles[j].Name.ValueString()
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource nd_roles with %d roles", len(data.Roles)))
+}
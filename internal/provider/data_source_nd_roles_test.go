@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceNdRoles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             testConfigResourceNdRoleCreate + testConfigDataSourceNdRoles,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.nd_roles.test", "roles.*", map[string]string{
+						"id":           "terraform_role",
+						"name":         "terraform_role",
+						"built_in":     "false",
+						"privileges.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.nd_roles.test", "roles.*", map[string]string{
+						"name":     "admin",
+						"built_in": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testConfigDataSourceNdRoles = `
+data "nd_roles" "test" {
+  depends_on = [nd_role.test]
+}
+`
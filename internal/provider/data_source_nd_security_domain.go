@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecurityDomainDataSource{}
+
+func NewSecurityDomainDataSource() datasource.DataSource {
+	return &SecurityDomainDataSource{}
+}
+
+// SecurityDomainDataSource defines the data source implementation.
+type SecurityDomainDataSource struct {
+	client *client.Client
+}
+
+// SecurityDomainDataModel describes the data source data model.
+type SecurityDomainDataModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Restricted  types.Bool   `tfsdk:"restricted"`
+	Fabrics     types.Set    `tfsdk:"fabrics"`
+}
+
+func (d *SecurityDomainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of datasource: nd_security_domain")
+	resp.TypeName = req.ProviderTypeName + "_security_domain"
+	tflog.Debug(ctx, "End metadata of datasource: nd_security_domain")
+}
+
+func (d *SecurityDomainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of datasource: nd_security_domain")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for Security Domains for Nexus Dashboard",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the security domain.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the security domain.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the security domain.",
+			},
+			"restricted": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "The restricted flag of the security domain.",
+			},
+			"fabrics": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the sites or fabrics linked to the security domain.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of datasource: nd_security_domain")
+}
+
+func (d *SecurityDomainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of datasource: nd_security_domain")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	tflog.Debug(ctx, "End configure of datasource: nd_security_domain")
+}
+
+func (d *SecurityDomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of datasource: nd_security_domain")
+	var data *SecurityDomainDataModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of datasource nd_security_domain with id '%s'", data.Name.ValueString()))
+
+	apiPath := d.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureSecurityDomains)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData := d.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, data.Name.ValueString()), "GET", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		resp.Diagnostics.AddError("Failed to read nd_security_domain data source", fmt.Sprintf("The security domain '%s' was not found.", data.Name.ValueString()))
+		return
+	}
+	*data = getSecurityDomainDataModel(ctx, &resp.Diagnostics, responseData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End read of datasource nd_security_domain with id '%s'", data.Id.ValueString()))
+}
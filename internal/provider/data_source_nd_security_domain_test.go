@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceNdSecurityDomain(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             testConfigResourceNdSecurityDomainUpdate + testConfigDataSourceNdSecurityDomain,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nd_security_domain.test", "id", "terraform_domain"),
+					resource.TestCheckResourceAttr("data.nd_security_domain.test", "name", "terraform_domain"),
+					resource.TestCheckResourceAttr("data.nd_security_domain.test", "description", "Created by Terraform"),
+					resource.TestCheckResourceAttr("data.nd_security_domain.test", "restricted", "true"),
+					resource.TestCheckResourceAttr("data.nd_security_domain.test", "fabrics.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.nd_security_domain.test", "fabrics.*", "nd1"),
+				),
+			},
+		},
+	})
+}
+
+const testConfigDataSourceNdSecurityDomain = `
+data "nd_security_domain" "test" {
+  name = nd_security_domain.test.name
+}
+`
@@ -5,9 +5,11 @@ import (
 	"fmt"
 
 	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -32,17 +34,20 @@ func (d *VersionDataSource) Metadata(ctx context.Context, req datasource.Metadat
 }
 
 type VersionResourceModel struct {
-	Id          types.String  `tfsdk:"commit_id"`
-	BuildTime   types.String  `tfsdk:"build_time"`
-	BuildHost   types.String  `tfsdk:"build_host"`
-	User        types.String  `tfsdk:"user"`
-	ProductId   types.String  `tfsdk:"product_id"`
-	ProductName types.String  `tfsdk:"product_name"`
-	Release     types.Bool    `tfsdk:"release"`
-	Major       types.Float64 `tfsdk:"major"`
-	Minor       types.Float64 `tfsdk:"minor"`
-	Maintenance types.Float64 `tfsdk:"maintenance"`
-	Patch       types.String  `tfsdk:"patch"`
+	Id                types.String `tfsdk:"commit_id"`
+	BuildTime         types.String `tfsdk:"build_time"`
+	BuildHost         types.String `tfsdk:"build_host"`
+	User              types.String `tfsdk:"user"`
+	ProductId         types.String `tfsdk:"product_id"`
+	ProductName       types.String `tfsdk:"product_name"`
+	Release           types.Bool   `tfsdk:"release"`
+	Version           types.String `tfsdk:"version"`
+	Major             types.Int64  `tfsdk:"major"`
+	Minor             types.Int64  `tfsdk:"minor"`
+	Maintenance       types.Int64  `tfsdk:"maintenance"`
+	Patch             types.String `tfsdk:"patch"`
+	MinimumVersion    types.String `tfsdk:"minimum_version"`
+	VersionConstraint types.String `tfsdk:"version_constraint"`
 }
 
 func (d *VersionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -80,15 +85,19 @@ func (d *VersionDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:            true,
 				MarkdownDescription: "The release status of the ND Platform Version.",
 			},
-			"major": schema.Float64Attribute{
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The normalized version of the ND Platform Version in the format `major.minor.maintenance` followed by the patch letter, ie `3.2.1e`.",
+			},
+			"major": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The major version number of the ND Platform Version.",
 			},
-			"minor": schema.Float64Attribute{
+			"minor": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The minor version number of the ND Platform Version.",
 			},
-			"maintenance": schema.Float64Attribute{
+			"maintenance": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The maintenance version number of the ND Platform Version.",
 			},
@@ -96,6 +105,14 @@ func (d *VersionDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:            true,
 				MarkdownDescription: "The patch version letter of the ND Platform Version.",
 			},
+			"minimum_version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The minimum version of the ND Platform, ie `3.2.1e`. The data source fails when the version of the ND Platform is lower. Patch letters are ordered alphabetically, ie `3.2.1` < `3.2.1a` < `3.2.1e` < `3.2.2`.",
+			},
+			"version_constraint": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The comma separated version constraints of the ND Platform, ie `>= 3.2.1e, < 4.2`. The data source fails when the version of the ND Platform does not satisfy all constraints. The supported operators are `=`, `!=`, `>`, `>=`, `<`, `<=` and `~>`.",
+			},
 		},
 	}
 	tflog.Debug(ctx, "End schema of datasource: nd_version")
@@ -143,65 +160,74 @@ func (d *VersionDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	checkVersionConstraints(&resp.Diagnostics, data)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Debug(ctx, fmt.Sprintf("End read of datasource nd_version with id '%s'", data.Id.ValueString()))
 }
 
 func getAndSetVersionAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *VersionResourceModel) {
-	requestData := client.DoRestRequest(ctx, diags, "version.json", "GET", nil)
-	if diags.HasError() {
+	version, err := client.Version.Get(ctx)
+	if err != nil {
+		diags.AddError(
+			"The GET /version.json rest request failed.",
+			fmt.Sprintf("Err: %s. Please report this issue to the provider developers.", err),
+		)
 		return
 	}
-	if requestData.Data() != nil {
-		classReadInfo := requestData.Data().(map[string]interface{})
-		for attributeName, attributeValue := range classReadInfo {
-
-			if attributeName == "commit_id" {
-				data.Id = basetypes.NewStringValue(attributeValue.(string))
-			}
-
-			if attributeName == "build_time" {
-				data.BuildTime = basetypes.NewStringValue(attributeValue.(string))
-			}
 
-			if attributeName == "build_host" {
-				data.BuildHost = basetypes.NewStringValue(attributeValue.(string))
-			}
-
-			if attributeName == "user" {
-				data.User = basetypes.NewStringValue(attributeValue.(string))
-			}
-
-			if attributeName == "product_id" {
-				data.ProductId = basetypes.NewStringValue(attributeValue.(string))
-			}
-
-			if attributeName == "product_name" {
-				data.ProductName = basetypes.NewStringValue(attributeValue.(string))
-			}
-
-			if attributeName == "release" {
-				data.Release = basetypes.NewBoolValue(attributeValue.(bool))
-			}
-
-			if attributeName == "major" {
-				data.Major = basetypes.NewFloat64Value(attributeValue.(float64))
-			}
+	data.Id = basetypes.NewStringValue(version.CommitId)
+	data.BuildTime = basetypes.NewStringValue(version.BuildTime)
+	data.BuildHost = basetypes.NewStringValue(version.BuildHost)
+	data.User = basetypes.NewStringValue(version.User)
+	data.ProductId = basetypes.NewStringValue(version.ProductId)
+	data.ProductName = basetypes.NewStringValue(version.ProductName)
+	data.Release = basetypes.NewBoolValue(version.Release)
+	data.Version = basetypes.NewStringValue(version.String())
+	data.Major = basetypes.NewInt64Value(int64(version.Major))
+	data.Minor = basetypes.NewInt64Value(int64(version.Minor))
+	data.Maintenance = basetypes.NewInt64Value(int64(version.Maintenance))
+	data.Patch = basetypes.NewStringValue(version.Patch)
+}
 
-			if attributeName == "minor" {
-				data.Minor = basetypes.NewFloat64Value(attributeValue.(float64))
-			}
+// checkVersionConstraints adds an error when the version does not satisfy the minimum_version or version_constraint attributes.
+func checkVersionConstraints(diags *diag.Diagnostics, data *VersionResourceModel) {
+	version, err := nd.ParseVersion(data.Version.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Failed to parse the ND version",
+			fmt.Sprintf("Error: %s. Please report this issue to the provider developers.", err),
+		)
+		return
+	}
 
-			if attributeName == "maintenance" {
-				data.Maintenance = basetypes.NewFloat64Value(attributeValue.(float64))
-			}
+	if !data.MinimumVersion.IsNull() && !data.MinimumVersion.IsUnknown() {
+		minimumVersion, err := nd.ParseVersion(data.MinimumVersion.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("minimum_version"), "Invalid minimum_version", err.Error())
+		} else if version.Compare(minimumVersion) < 0 {
+			diags.AddAttributeError(
+				path.Root("minimum_version"),
+				"ND version is lower than the minimum version",
+				fmt.Sprintf("The version of ND is %s, the minimum version is %s.", version, minimumVersion),
+			)
+		}
+	}
 
-			if attributeName == "patch" {
-				data.Patch = basetypes.NewStringValue(attributeValue.(string))
-			}
+	if !data.VersionConstraint.IsNull() && !data.VersionConstraint.IsUnknown() {
+		constraints, err := nd.ParseConstraints(data.VersionConstraint.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("version_constraint"), "Invalid version_constraint", err.Error())
+		} else if !constraints.Check(version) {
+			diags.AddAttributeError(
+				path.Root("version_constraint"),
+				"ND version does not satisfy the version constraint",
+				fmt.Sprintf("The version of ND is %s, the version constraint is '%s'.", version, data.VersionConstraint.ValueString()),
+			)
 		}
-	} else {
-		data.Id = basetypes.NewStringNull()
 	}
 }
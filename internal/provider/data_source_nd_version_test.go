@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -26,8 +27,30 @@ func TestAccDataSourceNdVersion(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.nd_version.test", "minor"),
 					resource.TestCheckResourceAttrSet("data.nd_version.test", "patch"),
 					resource.TestCheckResourceAttrSet("data.nd_version.test", "release"),
+					resource.TestMatchResourceAttr("data.nd_version.test", "version", regexp.MustCompile(`^\d+\.\d+\.\d+[a-z]*$`)),
 				),
 			},
+			{
+				Config:             testConfigNdVersionConstraints,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nd_version.test", "minimum_version", "2.3.2d"),
+					resource.TestCheckResourceAttr("data.nd_version.test", "version_constraint", ">= 2.3, < 99.0"),
+					resource.TestCheckResourceAttrSet("data.nd_version.test", "version"),
+				),
+			},
+			{
+				Config:      testConfigNdVersionMinimumVersionNotSatisfied,
+				ExpectError: regexp.MustCompile("ND version is lower than the minimum version"),
+			},
+			{
+				Config:      testConfigNdVersionConstraintNotSatisfied,
+				ExpectError: regexp.MustCompile("ND version does not satisfy the version constraint"),
+			},
+			{
+				Config:      testConfigNdVersionConstraintInvalid,
+				ExpectError: regexp.MustCompile("Invalid version_constraint"),
+			},
 		},
 	})
 }
@@ -36,3 +59,28 @@ const testConfigNdVersion = `
 data "nd_version" "test" {
 }
 `
+
+const testConfigNdVersionConstraints = `
+data "nd_version" "test" {
+  minimum_version    = "2.3.2d"
+  version_constraint = ">= 2.3, < 99.0"
+}
+`
+
+const testConfigNdVersionMinimumVersionNotSatisfied = `
+data "nd_version" "test" {
+  minimum_version = "99.0.1a"
+}
+`
+
+const testConfigNdVersionConstraintNotSatisfied = `
+data "nd_version" "test" {
+  version_constraint = "< 2.3"
+}
+`
+
+const testConfigNdVersionConstraintInvalid = `
+data "nd_version" "test" {
+  version_constraint = "=> 3.2"
+}
+`
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ApicInbandEpgDnFunction{}
+
+func NewApicInbandEpgDnFunction() function.Function {
+	return &ApicInbandEpgDnFunction{}
+}
+
+// ApicInbandEpgDnFunction defines the function implementation.
+type ApicInbandEpgDnFunction struct{}
+
+func (f *ApicInbandEpgDnFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "apic_inband_epg_dn"
+}
+
+func (f *ApicInbandEpgDnFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build the DN of an APIC in-band EPG",
+		MarkdownDescription: "Returns the distinguished name of the in-band EPG with the name in the APIC management tenant, ie `uni/tn-mgmt/mgmtp-default/inb-<name>`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name of the in-band EPG.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ApicInbandEpgDnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	if name == "" {
+		resp.Error = function.NewArgumentFuncError(0, "The name of the in-band EPG must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, getInbandEpgDn(name)))
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestApicInbandEpgDnFunction(t *testing.T) {
+	result, err := runTestFunction(t, NewApicInbandEpgDnFunction(), types.StringValue("inband"))
+	if err != nil {
+		t.Fatalf("apic_inband_epg_dn returned an error: %s", err)
+	}
+	if !result.Equal(types.StringValue("uni/tn-mgmt/mgmtp-default/inb-inband")) {
+		t.Errorf("apic_inband_epg_dn(\"inband\") = %s", result)
+	}
+
+	if _, err = runTestFunction(t, NewApicInbandEpgDnFunction(), types.StringValue("")); err == nil {
+		t.Error("expected an error for an empty name")
+	}
+}
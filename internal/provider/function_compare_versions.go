@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &CompareVersionsFunction{}
+
+func NewCompareVersionsFunction() function.Function {
+	return &CompareVersionsFunction{}
+}
+
+// CompareVersionsFunction defines the function implementation.
+type CompareVersionsFunction struct{}
+
+func (f *CompareVersionsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "compare_versions"
+}
+
+func (f *CompareVersionsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Compare two ND versions",
+		MarkdownDescription: "Compares two ND versions, ie `3.2.1e` and `4.1`, and returns `-1` when the first version is lower than the second version, `0` when the versions are equal and `1` when the first version is higher than the second version.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "a",
+				MarkdownDescription: "The first version.",
+			},
+			function.StringParameter{
+				Name:                "b",
+				MarkdownDescription: "The second version.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *CompareVersionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	versionA, err := nd.ParseVersion(a)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid version: %s", err))
+		return
+	}
+	versionB, err := nd.ParseVersion(b)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid version: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, int64(versionA.Compare(versionB))))
+}
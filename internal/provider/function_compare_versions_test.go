@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCompareVersionsFunction(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int64
+	}{
+		{"3.2.1e", "3.2.1e", 0},
+		{"3.2.1e", "4.1", -1},
+		{"4.1.1g", "4.1", 1},
+		{"3.2.1e", "3.2.1f", -1},
+		{"3.2.2", "3.2.1e", 1},
+		{" 4.1.1G ", "4.1.1g", 0},
+	}
+	for _, test := range tests {
+		result, err := runTestFunction(t, NewCompareVersionsFunction(), types.StringValue(test.a), types.StringValue(test.b))
+		if err != nil {
+			t.Fatalf("compare_versions(%q, %q) returned an error: %s", test.a, test.b, err)
+		}
+		if !result.Equal(types.Int64Value(test.expected)) {
+			t.Errorf("compare_versions(%q, %q) = %s, expected %d", test.a, test.b, result, test.expected)
+		}
+	}
+}
+
+func TestCompareVersionsFunctionInvalidVersion(t *testing.T) {
+	_, err := runTestFunction(t, NewCompareVersionsFunction(), types.StringValue("4.1"), types.StringValue("invalid"))
+	if err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if err.FunctionArgument == nil || *err.FunctionArgument != 1 {
+		t.Errorf("expected the error to reference the second argument, got %v", err.FunctionArgument)
+	}
+}
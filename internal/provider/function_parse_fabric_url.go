@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseFabricUrlFunction{}
+
+var fabricUrlAttributeTypes = map[string]attr.Type{
+	"scheme": types.StringType,
+	"host":   types.StringType,
+	"port":   types.Int64Type,
+	"path":   types.StringType,
+}
+
+var fabricUrlDefaultPorts = map[string]int64{
+	"http":  80,
+	"https": 443,
+}
+
+func NewParseFabricUrlFunction() function.Function {
+	return &ParseFabricUrlFunction{}
+}
+
+// ParseFabricUrlFunction defines the function implementation.
+type ParseFabricUrlFunction struct{}
+
+type FabricUrlModel struct {
+	Scheme types.String `tfsdk:"scheme"`
+	Host   types.String `tfsdk:"host"`
+	Port   types.Int64  `tfsdk:"port"`
+	Path   types.String `tfsdk:"path"`
+}
+
+func (f *ParseFabricUrlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_fabric_url"
+}
+
+func (f *ParseFabricUrlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse the URL or hostname of a fabric",
+		MarkdownDescription: "Parses the URL or hostname of a fabric, ie the `hostname` of the `nd_multi_cluster_connectivity` resource, and returns an object with the `scheme`, `host`, `port` and `path`. The scheme defaults to `https` and the port defaults to the port of the scheme when they are not part of the URL.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "The URL or hostname of the fabric.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: fabricUrlAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseFabricUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rawUrl string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &rawUrl))
+	if resp.Error != nil {
+		return
+	}
+
+	fabricUrl, err := parseFabricUrl(rawUrl)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fabricUrl))
+}
+
+// parseFabricUrl parses a URL or a hostname, a hostname is handled as a https URL.
+func parseFabricUrl(rawUrl string) (*FabricUrlModel, error) {
+	rawUrl = strings.TrimSpace(rawUrl)
+	if rawUrl == "" {
+		return nil, fmt.Errorf("the URL of the fabric must not be empty")
+	}
+	if !strings.Contains(rawUrl, "://") {
+		rawUrl = "https://" + rawUrl
+	}
+
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL '%s': %s", rawUrl, err)
+	}
+
+	scheme := strings.ToLower(parsedUrl.Scheme)
+	defaultPort, ok := fabricUrlDefaultPorts[scheme]
+	if !ok {
+		return nil, fmt.Errorf("invalid URL '%s': the scheme must be http or https", rawUrl)
+	}
+	if parsedUrl.Hostname() == "" {
+		return nil, fmt.Errorf("invalid URL '%s': the host is missing", rawUrl)
+	}
+
+	port := defaultPort
+	if parsedUrl.Port() != "" {
+		port, err = strconv.ParseInt(parsedUrl.Port(), 10, 64)
+		if err != nil || port < 1 || port > 65535 {
+			return nil, fmt.Errorf("invalid URL '%s': the port must be between 1 and 65535", rawUrl)
+		}
+	}
+
+	return &FabricUrlModel{
+		Scheme: types.StringValue(scheme),
+		Host:   types.StringValue(parsedUrl.Hostname()),
+		Port:   types.Int64Value(port),
+		Path:   types.StringValue(parsedUrl.Path),
+	}, nil
+}
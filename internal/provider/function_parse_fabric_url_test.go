@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseFabricUrlFunction(t *testing.T) {
+	tests := []struct {
+		url    string
+		scheme string
+		host   string
+		port   int64
+		path   string
+	}{
+		{"10.0.0.1", "https", "10.0.0.1", 443, ""},
+		{"apic.example.com:8443", "https", "apic.example.com", 8443, ""},
+		{"https://apic.example.com/", "https", "apic.example.com", 443, "/"},
+		{"HTTP://apic.example.com", "http", "apic.example.com", 80, ""},
+		{"https://[2001:db8::1]:8443/api", "https", "2001:db8::1", 8443, "/api"},
+	}
+	for _, test := range tests {
+		result, err := runTestFunction(t, NewParseFabricUrlFunction(), types.StringValue(test.url))
+		if err != nil {
+			t.Fatalf("parse_fabric_url(%q) returned an error: %s", test.url, err)
+		}
+		expected := types.ObjectValueMust(fabricUrlAttributeTypes, map[string]attr.Value{
+			"scheme": types.StringValue(test.scheme),
+			"host":   types.StringValue(test.host),
+			"port":   types.Int64Value(test.port),
+			"path":   types.StringValue(test.path),
+		})
+		if !result.Equal(expected) {
+			t.Errorf("parse_fabric_url(%q) = %s, expected %s", test.url, result, expected)
+		}
+	}
+
+	for _, url := range []string{"", "ftp://apic.example.com", "https://", "https://apic.example.com:70000"} {
+		if _, err := runTestFunction(t, NewParseFabricUrlFunction(), types.StringValue(url)); err == nil {
+			t.Errorf("expected an error for parse_fabric_url(%q)", url)
+		}
+	}
+}
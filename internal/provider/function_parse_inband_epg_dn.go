@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ParseInbandEpgDnFunction{}
+
+func NewParseInbandEpgDnFunction() function.Function {
+	return &ParseInbandEpgDnFunction{}
+}
+
+// ParseInbandEpgDnFunction defines the function implementation.
+type ParseInbandEpgDnFunction struct{}
+
+func (f *ParseInbandEpgDnFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_inband_epg_dn"
+}
+
+func (f *ParseInbandEpgDnFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse the name from the DN of an APIC in-band EPG",
+		MarkdownDescription: "Returns the name of the in-band EPG from its distinguished name, ie `inband` for `uni/tn-mgmt/mgmtp-default/inb-inband`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "dn",
+				MarkdownDescription: "The distinguished name of the in-band EPG.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ParseInbandEpgDnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var dn string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &dn))
+	if resp.Error != nil {
+		return
+	}
+
+	name := getInbandEpgName(dn)
+	if name == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid in-band EPG DN '%s', the DN must end with '%s<name>'", dn, inbandEpgDnSeparator))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, name))
+}
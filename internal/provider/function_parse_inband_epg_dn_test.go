@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseInbandEpgDnFunction(t *testing.T) {
+	tests := map[string]string{
+		"uni/tn-mgmt/mgmtp-default/inb-inband":   "inband",
+		"uni/tn-mgmt/mgmtp-default/inb-epg-inb-": "epg-inb-",
+		"uni/tn-mgmt/mgmtp-default/inb-a/inb-b":  "b",
+	}
+	for dn, expected := range tests {
+		result, err := runTestFunction(t, NewParseInbandEpgDnFunction(), types.StringValue(dn))
+		if err != nil {
+			t.Fatalf("parse_inband_epg_dn(%q) returned an error: %s", dn, err)
+		}
+		if !result.Equal(types.StringValue(expected)) {
+			t.Errorf("parse_inband_epg_dn(%q) = %s, expected %q", dn, result, expected)
+		}
+	}
+
+	for _, dn := range []string{"", "uni/tn-mgmt/mgmtp-default/oob-default", "uni/tn-mgmt/mgmtp-default/inb-"} {
+		if _, err := runTestFunction(t, NewParseInbandEpgDnFunction(), types.StringValue(dn)); err == nil {
+			t.Errorf("expected an error for parse_inband_epg_dn(%q)", dn)
+		}
+	}
+}
+
+func TestInbandEpgDnRoundTrip(t *testing.T) {
+	if name := getInbandEpgName(getInbandEpgDn("inband")); name != "inband" {
+		t.Errorf("expected the name 'inband', got %q", name)
+	}
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &ClusterListResource{}
+var _ list.ListResourceWithConfigure = &ClusterListResource{}
+
+func NewClusterListResource() list.ListResource {
+	return &ClusterListResource{}
+}
+
+// ClusterListResource defines the list resource implementation.
+type ClusterListResource struct {
+	client *client.Client
+}
+
+// ClusterListModel describes the list resource filters.
+type ClusterListModel struct {
+	ClusterType     types.String `tfsdk:"type"`
+	FabricNameRegex types.String `tfsdk:"fabric_name_regex"`
+	HostnameRegex   types.String `tfsdk:"hostname_regex"`
+}
+
+func (r *ClusterListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of list resource: nd_multi_cluster_connectivity")
+	resp.TypeName = req.ProviderTypeName + "_multi_cluster_connectivity"
+	tflog.Debug(ctx, "End metadata of list resource: nd_multi_cluster_connectivity")
+}
+
+func (r *ClusterListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	tflog.Debug(ctx, "Start schema of list resource: nd_multi_cluster_connectivity")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the clusters connected to Nexus Dashboard, all clusters are returned when no filter is configured.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the clusters of the type. Allowed values are 'apic' or 'nd'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("apic", "nd"),
+				},
+			},
+			"fabric_name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the clusters with a name that matches the regular expression.",
+			},
+			"hostname_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the clusters with a URL or hostname that matches the regular expression.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of list resource: nd_multi_cluster_connectivity")
+}
+
+func (r *ClusterListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of list resource: nd_multi_cluster_connectivity")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of list resource: nd_multi_cluster_connectivity")
+}
+
+func (r *ClusterListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "Start list of resource: nd_multi_cluster_connectivity")
+	var diags diag.Diagnostics
+	var filters ClusterListModel
+
+	diags.Append(req.Config.Get(ctx, &filters)...)
+	fabricNameRegex := getListFilterRegex(&diags, filters.FabricNameRegex, "fabric_name_regex")
+	hostnameRegex := getListFilterRegex(&diags, filters.HostnameRegex, "hostname_regex")
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	clusterPath := r.client.GetPath(ctx, &diags, nd.FeatureClusters)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	responseData := r.client.DoRestRequest(ctx, &diags, clusterPath, "GET", nil)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	clusters := []*ClusterResourceModel{}
+	if responseData != nil {
+		for _, item := range getResponseItems(responseData) {
+			clusterData, ok := item.Data().(map[string]interface{})
+			if !ok {
+				continue
+			}
+			// The credentials and login domains are not returned by ND and must be added to the generated configuration.
+			data := getBaseClusterResourceModel(basetypes.NewStringNull(), basetypes.NewStringNull(), basetypes.NewStringNull(), basetypes.NewStringNull())
+			setClusterAttributes(ctx, data, clusterData)
+			if data.FabricName.IsNull() || !clusterMatchesListFilters(data, filters, fabricNameRegex, hostnameRegex) {
+				continue
+			}
+			clusters = append(clusters, data)
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for index, data := range clusters {
+			if req.Limit > 0 && int64(index) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = data.FabricName.ValueString()
+			result.Diagnostics.Append(result.Identity.Set(ctx, ClusterIdentityModel{FabricName: data.FabricName})...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, data)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End list of resource nd_multi_cluster_connectivity with %d clusters", len(clusters)))
+}
+
+// getListFilterRegex compiles the regular expression of a list filter, nil is returned when the filter is not configured.
+func getListFilterRegex(diags *diag.Diagnostics, filter types.String, attributeName string) *regexp.Regexp {
+	if filter.IsNull() || filter.IsUnknown() {
+		return nil
+	}
+	regex, err := regexp.Compile(filter.ValueString())
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Invalid %s", attributeName),
+			fmt.Sprintf("The regular expression '%s' is invalid: %s.", filter.ValueString(), err),
+		)
+		return nil
+	}
+	return regex
+}
+
+func clusterMatchesListFilters(data *ClusterResourceModel, filters ClusterListModel, fabricNameRegex, hostnameRegex *regexp.Regexp) bool {
+	if !filters.ClusterType.IsNull() && !strings.EqualFold(filters.ClusterType.ValueString(), data.ClusterType.ValueString()) {
+		return false
+	}
+	if fabricNameRegex != nil && !fabricNameRegex.MatchString(data.FabricName.ValueString()) {
+		return false
+	}
+	if hostnameRegex != nil && !hostnameRegex.MatchString(data.ClusterHostname.ValueString()) {
+		return false
+	}
+	return true
+}
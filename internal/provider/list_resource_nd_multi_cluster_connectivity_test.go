@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	acctest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccListResourceNdMultiClusterConnectivity(t *testing.T) {
+	acctest.Test(t, acctest.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []acctest.TestStep{
+			{
+				Config: testConfigResourceNdMultiClusterConnectivityCreate,
+			},
+			{
+				Query:  true,
+				Config: testConfigListNdMultiClusterConnectivity,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("nd_multi_cluster_connectivity.test", map[string]knownvalue.Check{
+						"fabric_name": knownvalue.StringExact("nd1"),
+					}),
+				},
+			},
+		},
+	})
+}
+
+const testConfigListNdMultiClusterConnectivity = `
+list "nd_multi_cluster_connectivity" "test" {
+  provider = nd
+  config {
+    type              = "nd"
+    fabric_name_regex = "^nd1$"
+  }
+}
+`
+
+func TestClusterListResourceFilters(t *testing.T) {
+	r := &ClusterListResource{client: newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/infra/clusters" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"items": [
+			{"spec": {"name": "nd1", "clusterType": "ND", "onboardUrl": "198.18.133.203"}},
+			{"spec": {"name": "apic1", "clusterType": "APIC", "onboardUrl": "https://apic1.example.com", "aci": {"licenseTier": "advantage", "securityDomain": "all", "telemetry": {"status": "enabled", "epg": "uni/tn-mgmt/mgmtp-default/inb-inband"}, "orchestration": {"status": "disabled"}}}},
+			{"spec": {"name": "apic2", "clusterType": "APIC", "onboardUrl": "https://apic2.example.com", "aci": {"licenseTier": "advantage", "securityDomain": "all", "telemetry": {"status": "disabled", "epg": ""}, "orchestration": {"status": "enabled"}}}}
+		]}`)
+	})}
+
+	tests := []struct {
+		name     string
+		filters  ClusterListModel
+		limit    int64
+		expected []string
+	}{
+		{"all", ClusterListModel{}, 0, []string{"nd1", "apic1", "apic2"}},
+		{"type", ClusterListModel{ClusterType: types.StringValue("apic")}, 0, []string{"apic1", "apic2"}},
+		{"fabric_name_regex", ClusterListModel{FabricNameRegex: types.StringValue("^apic2$")}, 0, []string{"apic2"}},
+		{"hostname_regex", ClusterListModel{HostnameRegex: types.StringValue("apic1")}, 0, []string{"apic1"}},
+		{"limit", ClusterListModel{}, 2, []string{"nd1", "apic1"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := runTestClusterList(t, r, test.filters, test.limit)
+			names := []string{}
+			for _, result := range results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", result.Diagnostics)
+				}
+				var identity ClusterIdentityModel
+				result.Diagnostics.Append(result.Identity.Get(context.Background(), &identity)...)
+				if identity.FabricName.ValueString() != result.DisplayName {
+					t.Errorf("expected the identity %q to match the display name %q", identity.FabricName.ValueString(), result.DisplayName)
+				}
+				names = append(names, result.DisplayName)
+			}
+			if !slices.Equal(names, test.expected) {
+				t.Errorf("List() = %v, expected %v", names, test.expected)
+			}
+		})
+	}
+
+	results := runTestClusterList(t, r, ClusterListModel{FabricNameRegex: types.StringValue("^apic1$")}, 0)
+	var data ClusterResourceModel
+	results[0].Diagnostics.Append(results[0].Resource.Get(context.Background(), &data)...)
+	if results[0].Diagnostics.HasError() || data.InbandEpg.ValueString() != "inband" || data.ClusterHostname.ValueString() != "https://apic1.example.com" {
+		t.Errorf("unexpected resource %v, %v", data, results[0].Diagnostics)
+	}
+
+	results = runTestClusterList(t, r, ClusterListModel{FabricNameRegex: types.StringValue("[")}, 0)
+	if len(results) != 1 || !results[0].Diagnostics.HasError() {
+		t.Errorf("expected an error for an invalid regular expression, got %v", results)
+	}
+}
+
+func runTestClusterList(t *testing.T, r *ClusterListResource, filters ClusterListModel, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &list.ListResourceSchemaResponse{}
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, schemaResp)
+	resourceSchemaResp := &resource.SchemaResponse{}
+	(&ClusterResource{}).Schema(ctx, resource.SchemaRequest{}, resourceSchemaResp)
+	identitySchemaResp := &resource.IdentitySchemaResponse{}
+	(&ClusterResource{}).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResp)
+
+	stream := &list.ListResultsStream{}
+	r.List(ctx, list.ListRequest{
+		Config:                 newTestConfig(t, tfsdk.State{Schema: schemaResp.Schema}, &filters),
+		IncludeResource:        true,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}, stream)
+	return slices.Collect(stream.Results)
+}
@@ -6,11 +6,18 @@ import (
 	"os"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,7 +28,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &ndProvider{}
+	_ provider.Provider                  = &ndProvider{}
+	_ provider.ProviderWithFunctions     = &ndProvider{}
+	_ provider.ProviderWithListResources = &ndProvider{}
+	_ provider.ProviderWithActions       = &ndProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -43,14 +53,16 @@ type ndProvider struct {
 
 // ndProviderModel describes the provider data model.
 type ndProviderModel struct {
-	Username    types.String `tfsdk:"username"`
-	Password    types.String `tfsdk:"password"`
-	URL         types.String `tfsdk:"url"`
-	LoginDomain types.String `tfsdk:"login_domain"`
-	IsInsecure  types.Bool   `tfsdk:"insecure"`
-	ProxyUrl    types.String `tfsdk:"proxy_url"`
-	ProxyCreds  types.String `tfsdk:"proxy_creds"`
-	MaxRetries  types.Int64  `tfsdk:"retries"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	ApiKey              types.String `tfsdk:"api_key"`
+	URL                 types.String `tfsdk:"url"`
+	LoginDomain         types.String `tfsdk:"login_domain"`
+	ValidateLoginDomain types.Bool   `tfsdk:"validate_login_domain"`
+	IsInsecure          types.Bool   `tfsdk:"insecure"`
+	ProxyUrl            types.String `tfsdk:"proxy_url"`
+	ProxyCreds          types.String `tfsdk:"proxy_creds"`
+	MaxRetries          types.Int64  `tfsdk:"retries"`
 }
 
 // Metadata returns the provider type name.
@@ -77,6 +89,14 @@ func (p *ndProvider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"api_key": schema.StringAttribute{
+				Description: "API key of the Nexus Dashboard Account, the requests are authenticated with the username and API key instead of the password when an API key is provided. This can also be set as the ND_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"url": schema.StringAttribute{
 				Description: "URL of the Cisco Nexus Dashboard web interface. This can also be set as the ND_URL environment variable.",
 				Optional:    true,
@@ -94,6 +114,10 @@ func (p *ndProvider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"validate_login_domain": schema.BoolAttribute{
+				Description: "Verify that the login domain exists in Nexus Dashboard when the provider is configured. This can also be set as the ND_VALIDATE_LOGIN_DOMAIN environment variable. Defaults to `false`.",
+				Optional:    true,
+			},
 			"insecure": schema.BoolAttribute{
 				Description: "Allow insecure HTTPS client. This can also be set as the ND_INSECURE environment variable. Defaults to `false`.",
 				Optional:    true,
@@ -138,10 +162,12 @@ func (p *ndProvider) Configure(ctx context.Context, req provider.ConfigureReques
 
 	username := getStringAttribute(data.Username, "ND_USERNAME")
 	password := getStringAttribute(data.Password, "ND_PASSWORD")
+	apiKey := getStringAttribute(data.ApiKey, "ND_API_KEY")
 	isInsecure := getBoolAttribute(resp, data.IsInsecure, "ND_INSECURE", false)
 	proxyUrl := getStringAttribute(data.ProxyUrl, "ND_PROXY_URL")
 	url := getStringAttribute(data.URL, "ND_URL")
 	loginDomain := getStringAttribute(data.LoginDomain, "ND_LOGIN_DOMAIN")
+	validateLoginDomain := getBoolAttribute(resp, data.ValidateLoginDomain, "ND_VALIDATE_LOGIN_DOMAIN", false)
 	proxyCreds := getStringAttribute(data.ProxyCreds, "ND_PROXY_CREDS")
 	maxRetries := int64(getIntAttribute(resp, data.MaxRetries, "ND_RETRIES", 2))
 
@@ -152,10 +178,17 @@ func (p *ndProvider) Configure(ctx context.Context, req provider.ConfigureReques
 		)
 	}
 
-	if password == "" {
+	if password == "" && apiKey == "" {
 		resp.Diagnostics.AddError(
 			"Authentication details not provided",
-			"Password must be provided for the ND provider",
+			"Password or API key must be provided for the ND provider",
+		)
+	}
+
+	if url == "" {
+		resp.Diagnostics.AddError(
+			"URL not provided",
+			"URL must be provided for the ND provider",
 		)
 	}
 
@@ -163,22 +196,96 @@ func (p *ndProvider) Configure(ctx context.Context, req provider.ConfigureReques
 		loginDomain = "DefaultAuth"
 	}
 
-	ndClient := client.GetClient(url, username, password, proxyUrl, proxyCreds, loginDomain, isInsecure, maxRetries)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ndClient, err := client.GetClient(url, username, password, apiKey, proxyUrl, proxyCreds, loginDomain, isInsecure, maxRetries)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create the ND client",
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	// The version of ND is not detected during the configuration, so that the configuration does not connect to ND.
+	// The version is detected by the first request of a resource or data source and cached by the client, the API paths
+	// supported by the version are selected from the cached version.
+
+	if validateLoginDomain {
+		checkLoginDomain(ctx, &resp.Diagnostics, ndClient, loginDomain)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	resp.DataSourceData = ndClient
 	resp.ResourceData = ndClient
+	resp.ListResourceData = ndClient
+	resp.ActionData = ndClient
 }
 
 func (p *ndProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
+	return append([]func() datasource.DataSource{
 		NewVersionDataSource,
 		NewClusterDataSource,
-	}
+		NewLocalUserDataSource,
+		NewLocalUsersDataSource,
+		NewSecurityDomainDataSource,
+		NewRolesDataSource,
+		NewCertificatesDataSource,
+		NewBackupsDataSource,
+	}, generatedDataSources()...)
 }
 
 func (p *ndProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return append([]func() resource.Resource{
 		NewClusterResource,
+		NewLocalUserResource,
+		NewSecurityDomainResource,
+		NewLoginDomainResource,
+		NewRoleResource,
+		NewApiKeyResource,
+		NewNtpServersResource,
+		NewDnsConfigResource,
+		NewStaticRouteResource,
+		NewExternalServicePoolResource,
+		NewRemoteLocationResource,
+		NewBackupScheduleResource,
+		NewFirmwareImageResource,
+		NewClusterUpgradeResource,
+		NewServiceResource,
+		NewEventExportResource,
+		NewSmtpConfigResource,
+		NewEmailNotificationResource,
+		NewCertificateResource,
+		NewTrustedCaResource,
+	}, generatedResources()...)
+}
+
+func (p *ndProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewClusterResyncAction,
+		NewBackupAction,
+		NewBackupDownloadAction,
+		NewRestoreAction,
+		NewSmtpTestEmailAction,
+	}
+}
+
+func (p *ndProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewClusterListResource,
+	}
+}
+
+func (p *ndProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCompareVersionsFunction,
+		NewApicInbandEpgDnFunction,
+		NewParseInbandEpgDnFunction,
+		NewParseFabricUrlFunction,
 	}
 }
 
@@ -224,3 +331,29 @@ func getIntAttribute(resp *provider.ConfigureResponse, attribute basetypes.Int64
 	}
 	return int(attribute.ValueInt64())
 }
+
+// checkLoginDomain adds an error to the diagnostics when the login domain does not exist in ND.
+// The local login domains are built into ND and are not checked.
+func checkLoginDomain(ctx context.Context, diags *diag.Diagnostics, ndClient *client.Client, loginDomain string) {
+	if strings.EqualFold(loginDomain, "DefaultAuth") || strings.EqualFold(loginDomain, "local") {
+		return
+	}
+
+	apiPath := ndClient.GetPath(ctx, diags, nd.FeatureLoginDomains)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := ndClient.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, loginDomain), "GET", nil)
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		diags.AddAttributeError(
+			path.Root("login_domain"),
+			"Login domain not found",
+			fmt.Sprintf("The login domain '%s' does not exist in ND.", loginDomain),
+		)
+	}
+}
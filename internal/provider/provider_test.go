@@ -1,11 +1,22 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -35,3 +46,81 @@ func testAccPreCheck(t *testing.T) {
 		}
 	}
 }
+
+// runTestFunction runs the provider function with the arguments and returns the result or the function error.
+func runTestFunction(t *testing.T, f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	definitionResp := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, definitionResp)
+	if definitionResp.Diagnostics.HasError() {
+		t.Fatalf("invalid function definition: %v", definitionResp.Diagnostics)
+	}
+
+	resp := &function.RunResponse{Result: function.NewResultData(definitionResp.Definition.Return.GetType().ValueType(ctx))}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+// newTestProviderClient returns a client connected to a mock ND server which serves the requests with the handler.
+func newTestProviderClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			fmt.Fprint(w, `{"token": "token"}`)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	ndClient, err := nd.NewClient(
+		server.URL,
+		nd.WithCredentials("admin", "password"),
+		nd.WithHTTPClient(server.Client()),
+		nd.WithMaxRetries(0),
+		nd.WithPlatformVersion("4.1.1g"),
+		nd.WithTaskPollInterval(time.Millisecond),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &client.Client{Client: ndClient}
+}
+
+// newTestConfig returns the configuration with the values of the model, the schema of the configuration is set in the state.
+func newTestConfig(t *testing.T, config tfsdk.State, value interface{}) tfsdk.Config {
+	t.Helper()
+	if diags := config.Set(context.Background(), value); diags.HasError() {
+		t.Fatalf("unable to set the configuration: %v", diags)
+	}
+	return tfsdk.Config{Raw: config.Raw, Schema: config.Schema}
+}
+
+func TestCheckLoginDomain(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/infra/aaa/loginDomains/ldap1" {
+			fmt.Fprint(w, `{"name": "ldap1", "realm": "ldap"}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	tests := []struct {
+		loginDomain string
+		expectError bool
+	}{
+		{"DefaultAuth", false},
+		{"local", false},
+		{"ldap1", false},
+		{"ldap2", true},
+	}
+	for _, test := range tests {
+		var diags diag.Diagnostics
+		checkLoginDomain(context.Background(), &diags, client, test.loginDomain)
+		if diags.HasError() != test.expectError {
+			t.Errorf("checkLoginDomain(%s) diagnostics = %v, expected error %t", test.loginDomain, diags, test.expectError)
+		}
+	}
+}
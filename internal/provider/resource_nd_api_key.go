@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ApiKeyResource{}
+var _ resource.ResourceWithImportState = &ApiKeyResource{}
+
+func NewApiKeyResource() resource.Resource {
+	return &ApiKeyResource{}
+}
+
+// ApiKeyResource defines the resource implementation.
+type ApiKeyResource struct {
+	client *client.Client
+}
+
+// ApiKeyResourceModel describes the resource data model.
+type ApiKeyResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	LoginId          types.String `tfsdk:"login_id"`
+	Description      types.String `tfsdk:"description"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+	Key              types.String `tfsdk:"key"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+func getBaseApiKeyResourceModel(key basetypes.StringValue, rotationTriggers basetypes.MapValue) *ApiKeyResourceModel {
+	return &ApiKeyResourceModel{
+		Id:               basetypes.NewStringNull(),
+		LoginId:          basetypes.NewStringNull(),
+		Description:      basetypes.NewStringNull(),
+		RotationTriggers: rotationTriggers,
+		Key:              key,
+		CreatedAt:        basetypes.NewStringNull(),
+	}
+}
+
+func (r *ApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_api_key")
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+	tflog.Debug(ctx, "End metadata of resource: nd_api_key")
+}
+
+func (r *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_api_key")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages API Keys for Nexus Dashboard. The key is only returned by ND when it is created, changing any attribute replaces the API key and revokes the previous one.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"login_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The login ID of the user authenticated with the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The description of the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values which rotate the API key when they change, ie a rotation date.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The API key, the key is only available in the state of the resource which created it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The creation time of the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_api_key")
+}
+
+func (r *ApiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_api_key")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_api_key")
+}
+
+func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_api_key")
+
+	var planData *ApiKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createApiKey(ctx, &resp.Diagnostics, r.client, planData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_api_key with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_api_key")
+	var stateData *ApiKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_api_key with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetApiKeyAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *ApiKeyResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_api_key with id '%s'", stateData.Id.ValueString()))
+}
+
+// Update is only called when the plan does not change the configured attributes, all of them require the replacement of the API key.
+func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_api_key")
+
+	var planData *ApiKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_api_key")
+}
+
+func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_api_key")
+	var stateData *ApiKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_api_key with id '%s'", stateData.Id.ValueString()))
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureAPIKeys)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting the API key revokes it, the requests authenticated with the key are rejected by ND.
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, stateData.Id.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_api_key with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *ApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_api_key")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_api_key with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_api_key")
+}
+
+// createApiKey creates the API key and reads its attributes. The key is only returned in the response of the creation,
+// so the created key is revoked when its attributes cannot be read, a key without a state would never be revoked.
+func createApiKey(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *ApiKeyResourceModel) {
+	jsonPayload := gabs.New()
+	setPayloadValue(diags, jsonPayload, data.LoginId.ValueString(), "/userName")
+	setPayloadValue(diags, jsonPayload, data.Description.ValueString(), "/annotation")
+
+	apiPath := client.GetPath(ctx, diags, nd.FeatureAPIKeys)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, apiPath, "POST", jsonPayload)
+
+	if diags.HasError() {
+		return
+	}
+
+	id := getResponseString(responseData, "/id")
+	key := getResponseString(responseData, "/apiKey")
+	if id == "" || key == "" {
+		diags.AddError(
+			"Failed to create nd_api_key",
+			"The ID or the key of the API key was not returned by ND. Please report this issue to the provider developers.",
+		)
+		return
+	}
+	data.Id = basetypes.NewStringValue(id)
+	data.Key = basetypes.NewStringValue(key)
+	getAndSetApiKeyAttributes(ctx, diags, client, data)
+
+	if diags.HasError() {
+		tflog.Debug(ctx, fmt.Sprintf("Revoke of the API key with id '%s' which could not be read after its creation", id))
+		var revokeDiags diag.Diagnostics
+		client.DoRestRequest(ctx, &revokeDiags, fmt.Sprintf("%s/%s", apiPath, id), "DELETE", nil)
+		if revokeDiags.HasError() {
+			diags.AddError(
+				"Failed to revoke nd_api_key",
+				fmt.Sprintf("The API key with ID '%s' was created but could not be read or revoked, revoke the API key in ND.", id),
+			)
+		}
+	}
+}
+
+func getAndSetApiKeyAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *ApiKeyResourceModel) {
+	apiPath := client.GetPath(ctx, diags, nd.FeatureAPIKeys)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "GET", nil)
+	// The API does not return the key, the key and rotation_triggers are assigned based on the state.
+	*data = *getBaseApiKeyResourceModel(data.Key, data.RotationTriggers)
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() != nil {
+		data.Id = basetypes.NewStringValue(getResponseString(responseData, "/id"))
+		data.LoginId = basetypes.NewStringValue(getResponseString(responseData, "/userName"))
+		data.Description = basetypes.NewStringValue(getResponseString(responseData, "/annotation"))
+		data.CreatedAt = basetypes.NewStringValue(getResponseString(responseData, "/createdAt"))
+	} else {
+		data.Id = basetypes.NewStringNull()
+	}
+}
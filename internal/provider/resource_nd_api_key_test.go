@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccResourceNdApiKey(t *testing.T) {
+	var id string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdApiKeyCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("nd_api_key.test", "id"),
+					resource.TestCheckResourceAttrSet("nd_api_key.test", "key"),
+					resource.TestCheckResourceAttr("nd_api_key.test", "login_id", "admin"),
+					resource.TestCheckResourceAttr("nd_api_key.test", "description", "Created by Terraform"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["nd_api_key.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Import and verify values, the key is not returned by ND after the creation
+			{
+				ResourceName:            "nd_api_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key", "rotation_triggers"},
+			},
+			// Rotate
+			{
+				Config:             testConfigResourceNdApiKeyRotate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("nd_api_key.test", "key"),
+					resource.TestCheckResourceAttr("nd_api_key.test", "rotation_triggers.rotated", "2026-01-01"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["nd_api_key.test"].Primary.ID == id {
+							return fmt.Errorf("expected the API key '%s' to be replaced", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestApiKeyAttributes(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/aaa/apiKeys/key1":
+			fmt.Fprint(w, `{"id": "key1", "userName": "admin", "annotation": "automation", "createdAt": "2026-01-01T00:00:00Z"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var diags diag.Diagnostics
+	data := getBaseApiKeyResourceModel(basetypes.NewStringValue("secret"), basetypes.NewMapNull(basetypes.StringType{}))
+	data.Id = basetypes.NewStringValue("key1")
+	getAndSetApiKeyAttributes(context.Background(), &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Id.ValueString() != "key1" || data.LoginId.ValueString() != "admin" || data.Description.ValueString() != "automation" || data.CreatedAt.ValueString() != "2026-01-01T00:00:00Z" {
+		t.Errorf("unexpected id %s, login_id %s, description %s and created_at %s", data.Id, data.LoginId, data.Description, data.CreatedAt)
+	}
+	if data.Key.ValueString() != "secret" {
+		t.Errorf("expected the key to be kept from the state, got %s", data.Key)
+	}
+
+	data.Id = basetypes.NewStringValue("key2")
+	getAndSetApiKeyAttributes(context.Background(), &diags, client, data)
+	if diags.HasError() || !data.Id.IsNull() {
+		t.Errorf("expected a null id for a revoked API key, got %s: %v", data.Id, diags)
+	}
+}
+
+func TestCreateApiKeyRevokedWhenReadFails(t *testing.T) {
+	var revoked bool
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v1/infra/aaa/apiKeys":
+			fmt.Fprint(w, `{"id": "key3", "apiKey": "secret"}`)
+		case r.Method == "GET" && r.URL.Path == "/api/v1/infra/aaa/apiKeys/key3":
+			w.WriteHeader(http.StatusInternalServerError)
+		case r.Method == "DELETE" && r.URL.Path == "/api/v1/infra/aaa/apiKeys/key3":
+			revoked = true
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var diags diag.Diagnostics
+	data := getBaseApiKeyResourceModel(basetypes.NewStringNull(), basetypes.NewMapNull(basetypes.StringType{}))
+	data.LoginId = basetypes.NewStringValue("admin")
+	createApiKey(context.Background(), &diags, client, data)
+	if !diags.HasError() {
+		t.Error("expected an error when the API key cannot be read after its creation")
+	}
+	if !revoked {
+		t.Error("expected the API key to be revoked when it cannot be read after its creation")
+	}
+}
+
+const testConfigResourceNdApiKeyCreate = `
+resource "nd_api_key" "test" {
+  login_id    = "admin"
+  description = "Created by Terraform"
+}
+`
+
+const testConfigResourceNdApiKeyRotate = `
+resource "nd_api_key" "test" {
+  login_id    = "admin"
+  description = "Created by Terraform"
+  rotation_triggers = {
+    rotated = "2026-01-01"
+  }
+}
+`
@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BackupScheduleResource{}
+var _ resource.ResourceWithImportState = &BackupScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &BackupScheduleResource{}
+
+func NewBackupScheduleResource() resource.Resource {
+	return &BackupScheduleResource{}
+}
+
+// BackupScheduleResource defines the resource implementation.
+type BackupScheduleResource struct {
+	client *client.Client
+}
+
+// BackupScheduleResourceModel describes the resource data model.
+type BackupScheduleResourceModel struct {
+	Id                          types.String `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	Description                 types.String `tfsdk:"description"`
+	Type                        types.String `tfsdk:"type"`
+	Frequency                   types.String `tfsdk:"frequency"`
+	StartTime                   types.String `tfsdk:"start_time"`
+	Retention                   types.Int64  `tfsdk:"retention"`
+	RemoteLocation              types.String `tfsdk:"remote_location"`
+	Enabled                     types.Bool   `tfsdk:"enabled"`
+	EncryptionPassphrase        types.String `tfsdk:"encryption_passphrase"`
+	EncryptionPassphraseVersion types.Int64  `tfsdk:"encryption_passphrase_version"`
+}
+
+func getBaseBackupScheduleResourceModel(encryptionPassphraseVersion basetypes.Int64Value) *BackupScheduleResourceModel {
+	return &BackupScheduleResourceModel{
+		Id:                          basetypes.NewStringNull(),
+		Name:                        basetypes.NewStringNull(),
+		Description:                 basetypes.NewStringNull(),
+		Type:                        basetypes.NewStringNull(),
+		Frequency:                   basetypes.NewStringNull(),
+		StartTime:                   basetypes.NewStringNull(),
+		Retention:                   basetypes.NewInt64Null(),
+		RemoteLocation:              basetypes.NewStringNull(),
+		Enabled:                     basetypes.NewBoolNull(),
+		EncryptionPassphrase:        basetypes.NewStringNull(),
+		EncryptionPassphraseVersion: encryptionPassphraseVersion,
+	}
+}
+
+func (r *BackupScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_backup_schedule")
+	resp.TypeName = req.ProviderTypeName + "_backup_schedule"
+	tflog.Debug(ctx, "End metadata of resource: nd_backup_schedule")
+}
+
+func (r *BackupScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_backup_schedule")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Scheduled Configuration Backups of Nexus Dashboard.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the backup schedule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the backup schedule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The description of the backup schedule.",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("config"),
+				MarkdownDescription: "The type of the backups, `config` only backs up the configuration and `full` also backs up the operational data.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("config", "full"),
+				},
+			},
+			"frequency": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The frequency of the backups.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("hourly", "daily", "weekly", "monthly"),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The time of the first backup in RFC 3339 format, ie `2026-01-01T02:00:00Z`.",
+			},
+			"retention": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(7),
+				MarkdownDescription: "The number of backups of the schedule kept by ND, the oldest backups are deleted.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"remote_location": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the remote location where the backups are stored, the backups are stored on ND when it is not provided.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the backups of the schedule are enabled.",
+			},
+			"encryption_passphrase": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The passphrase used to encrypt the backups, the passphrase is required to restore the backups. The passphrase is write-only and never stored in the state, it is sent to ND when the backup schedule is created and when the `encryption_passphrase_version` changes.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+				},
+			},
+			"encryption_passphrase_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of the encryption passphrase, changing the version updates the encryption passphrase of the backup schedule in ND.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_backup_schedule")
+}
+
+func (r *BackupScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData *BackupScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() || configData.StartTime.IsNull() || configData.StartTime.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, configData.StartTime.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start_time"),
+			"Invalid start_time",
+			fmt.Sprintf("The start time '%s' must be in RFC 3339 format, ie '2026-01-01T02:00:00Z'.", configData.StartTime.ValueString()),
+		)
+	}
+}
+
+func (r *BackupScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_backup_schedule")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_backup_schedule")
+}
+
+func (r *BackupScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_backup_schedule")
+
+	var planData *BackupScheduleResourceModel
+	var configData *BackupScheduleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getBackupScheduleJsonPayload(&resp.Diagnostics, planData, configData.EncryptionPassphrase)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureBackupSchedules)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "POST", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = types.StringValue(planData.Name.ValueString())
+	getAndSetBackupScheduleAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_backup_schedule with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *BackupScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_backup_schedule")
+	var stateData *BackupScheduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_backup_schedule with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetBackupScheduleAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *BackupScheduleResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_backup_schedule with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *BackupScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_backup_schedule")
+
+	var planData *BackupScheduleResourceModel
+	var stateData *BackupScheduleResourceModel
+	var configData *BackupScheduleResourceModel
+
+	// Read Terraform plan data and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_backup_schedule with id '%s'", planData.Id.ValueString()))
+
+	// The passphrase is only sent when the version changes, ND keeps the current passphrase when it is not provided.
+	encryptionPassphrase := basetypes.NewStringNull()
+	if !planData.EncryptionPassphraseVersion.Equal(stateData.EncryptionPassphraseVersion) {
+		encryptionPassphrase = configData.EncryptionPassphrase
+	}
+
+	jsonPayload := getBackupScheduleJsonPayload(&resp.Diagnostics, planData, encryptionPassphrase)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureBackupSchedules)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, planData.Id.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetBackupScheduleAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_backup_schedule")
+}
+
+func (r *BackupScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_backup_schedule")
+	var stateData *BackupScheduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_backup_schedule with id '%s'", stateData.Id.ValueString()))
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureBackupSchedules)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, stateData.Id.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_backup_schedule with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *BackupScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_backup_schedule")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_backup_schedule with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_backup_schedule")
+}
+
+// getBackupScheduleJsonPayload returns the payload of the backup schedule, the encryption passphrase is omitted when it is null.
+func getBackupScheduleJsonPayload(diags *diag.Diagnostics, data *BackupScheduleResourceModel, encryptionPassphrase types.String) *gabs.Container {
+	payload := gabs.New()
+
+	setPayloadValue(diags, payload, data.Name.ValueString(), "/name")
+	setPayloadValue(diags, payload, data.Description.ValueString(), "/description")
+	setPayloadValue(diags, payload, data.Type.ValueString(), "/type")
+	setPayloadValue(diags, payload, data.Frequency.ValueString(), "/frequency")
+	setPayloadValue(diags, payload, data.StartTime.ValueString(), "/startTime")
+	setPayloadValue(diags, payload, data.Retention.ValueInt64(), "/retention")
+	setPayloadValue(diags, payload, data.RemoteLocation.ValueString(), "/remoteLocation")
+	setPayloadValue(diags, payload, data.Enabled.ValueBool(), "/enabled")
+	if !encryptionPassphrase.IsNull() && !encryptionPassphrase.IsUnknown() {
+		setPayloadValue(diags, payload, encryptionPassphrase.ValueString(), "/encryptionKey")
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+func getAndSetBackupScheduleAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *BackupScheduleResourceModel) {
+	apiPath := client.GetPath(ctx, diags, nd.FeatureBackupSchedules)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "GET", nil)
+	// The API does not return the passphrase, the encryption_passphrase_version is assigned based on the user's configuration settings.
+	// The start time is kept from the state when it is the same time in another notation, ie with another time zone.
+	startTime := data.StartTime
+	*data = *getBaseBackupScheduleResourceModel(data.EncryptionPassphraseVersion)
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		return
+	}
+
+	name := getResponseString(responseData, "/name")
+	data.Id = basetypes.NewStringValue(name)
+	data.Name = basetypes.NewStringValue(name)
+	data.Description = basetypes.NewStringValue(getResponseString(responseData, "/description"))
+	data.Type = basetypes.NewStringValue(getResponseString(responseData, "/type"))
+	data.Frequency = basetypes.NewStringValue(getResponseString(responseData, "/frequency"))
+	data.StartTime = basetypes.NewStringValue(getResponseString(responseData, "/startTime"))
+	if isSameTime(startTime.ValueString(), data.StartTime.ValueString()) {
+		data.StartTime = startTime
+	}
+	data.Retention = basetypes.NewInt64Value(getResponseInt64(responseData, "/retention"))
+	if remoteLocation := getResponseString(responseData, "/remoteLocation"); remoteLocation != "" {
+		data.RemoteLocation = basetypes.NewStringValue(remoteLocation)
+	}
+	data.Enabled = basetypes.NewBoolValue(getResponseValue(responseData, "/enabled") == true)
+}
+
+// isSameTime reports whether the RFC 3339 times are the same instant.
+func isSameTime(a, b string) bool {
+	timeA, errA := time.Parse(time.RFC3339, a)
+	timeB, errB := time.Parse(time.RFC3339, b)
+	return errA == nil && errB == nil && timeA.Equal(timeB)
+}
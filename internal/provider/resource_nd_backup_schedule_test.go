@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceNdBackupSchedule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdBackupScheduleCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "id", "terraform_backup_schedule"),
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "name", "terraform_backup_schedule"),
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "description", ""),
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "type", "config"),
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "frequency", "daily"),
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "start_time", "2030-01-01T02:00:00Z"),
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "retention", "7"),
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "enabled", "true"),
+					resource.TestCheckNoResourceAttr("nd_backup_schedule.test", "remote_location"),
+					resource.TestCheckNoResourceAttr("nd_backup_schedule.test", "encryption_passphrase"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_backup_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdBackupScheduleUpdate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "description", "Terraform backup schedule"),
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "frequency", "weekly"),
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "retention", "4"),
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("nd_backup_schedule.test", "encryption_passphrase_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestBackupScheduleAttributes(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/backupSchedules/daily":
+			io.WriteString(w, `{"name": "daily", "type": "config", "frequency": "daily", "startTime": "2030-01-01T02:00:00Z", "retention": 7, "remoteLocation": "sftp_location", "enabled": true}`)
+		case "/api/v1/infra/backupSchedules/weekly":
+			io.WriteString(w, `{"name": "weekly", "type": "full", "frequency": "weekly", "startTime": "2030-01-01T03:00:00+01:00", "retention": 4}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseBackupScheduleResourceModel(basetypes.NewInt64Value(1))
+	data.Id = basetypes.NewStringValue("daily")
+	getAndSetBackupScheduleAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.RemoteLocation.ValueString() != "sftp_location" || data.Retention.ValueInt64() != 7 || !data.Enabled.ValueBool() || data.EncryptionPassphraseVersion.ValueInt64() != 1 {
+		t.Errorf("unexpected remote location %s, retention %s, enabled %s and passphrase version %s", data.RemoteLocation, data.Retention, data.Enabled, data.EncryptionPassphraseVersion)
+	}
+
+	// The start time of the state is kept when ND returns the same time in another time zone.
+	data.Id = basetypes.NewStringValue("weekly")
+	data.StartTime = basetypes.NewStringValue("2030-01-01T02:00:00Z")
+	getAndSetBackupScheduleAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.StartTime.ValueString() != "2030-01-01T02:00:00Z" || !data.RemoteLocation.IsNull() || data.Enabled.ValueBool() {
+		t.Errorf("unexpected start time %s, remote location %s and enabled %s", data.StartTime, data.RemoteLocation, data.Enabled)
+	}
+
+	// The passphrase is only sent when it is provided.
+	if payload := getBackupScheduleJsonPayload(&diags, data, basetypes.NewStringNull()); payload.Exists("encryptionKey") {
+		t.Errorf("unexpected payload %s", payload)
+	}
+	if payload := getBackupScheduleJsonPayload(&diags, data, basetypes.NewStringValue("passphrase")); payload.Path("encryptionKey").Data() != "passphrase" {
+		t.Errorf("unexpected payload %s", payload)
+	}
+}
+
+const testConfigResourceNdBackupScheduleCreate = `
+resource "nd_backup_schedule" "test" {
+  name                  = "terraform_backup_schedule"
+  frequency             = "daily"
+  start_time            = "2030-01-01T02:00:00Z"
+  encryption_passphrase = "terraform_passphrase"
+}
+`
+
+const testConfigResourceNdBackupScheduleUpdate = `
+resource "nd_backup_schedule" "test" {
+  name                          = "terraform_backup_schedule"
+  description                   = "Terraform backup schedule"
+  frequency                     = "weekly"
+  start_time                    = "2030-01-01T02:00:00Z"
+  retention                     = 4
+  enabled                       = false
+  encryption_passphrase         = "terraform_passphrase_updated"
+  encryption_passphrase_version = 2
+}
+`
@@ -0,0 +1,657 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CertificateResource{}
+var _ resource.ResourceWithImportState = &CertificateResource{}
+var _ resource.ResourceWithValidateConfig = &CertificateResource{}
+var _ resource.ResourceWithModifyPlan = &CertificateResource{}
+
+// certificateCsrType is the type of the csr attribute.
+var certificateCsrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"common_name":               types.StringType,
+		"organization":              types.StringType,
+		"organizational_unit":       types.StringType,
+		"locality":                  types.StringType,
+		"state":                     types.StringType,
+		"country":                   types.StringType,
+		"subject_alternative_names": types.ListType{ElemType: types.StringType},
+		"key_type":                  types.StringType,
+	},
+}
+
+func NewCertificateResource() resource.Resource {
+	return &CertificateResource{}
+}
+
+// CertificateResource defines the resource implementation.
+type CertificateResource struct {
+	client *client.Client
+}
+
+// CertificateResourceModel describes the resource data model.
+type CertificateResourceModel struct {
+	Id                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	Certificate               types.String `tfsdk:"certificate"`
+	PrivateKey                types.String `tfsdk:"private_key"`
+	Csr                       types.Object `tfsdk:"csr"`
+	CertificateSigningRequest types.String `tfsdk:"certificate_signing_request"`
+	WebUi                     types.Bool   `tfsdk:"web_ui"`
+	Subject                   types.String `tfsdk:"subject"`
+	Issuer                    types.String `tfsdk:"issuer"`
+	SubjectAlternativeNames   types.List   `tfsdk:"subject_alternative_names"`
+	NotBefore                 types.String `tfsdk:"not_before"`
+	NotAfter                  types.String `tfsdk:"not_after"`
+	SecretsVersion            types.Int64  `tfsdk:"secrets_version"`
+}
+
+// CertificateCsrModel describes the subject of the certificate signing request generated by ND.
+type CertificateCsrModel struct {
+	CommonName              types.String `tfsdk:"common_name"`
+	Organization            types.String `tfsdk:"organization"`
+	OrganizationalUnit      types.String `tfsdk:"organizational_unit"`
+	Locality                types.String `tfsdk:"locality"`
+	State                   types.String `tfsdk:"state"`
+	Country                 types.String `tfsdk:"country"`
+	SubjectAlternativeNames types.List   `tfsdk:"subject_alternative_names"`
+	KeyType                 types.String `tfsdk:"key_type"`
+}
+
+func getBaseCertificateResourceModel(webUi basetypes.BoolValue, secretsVersion basetypes.Int64Value) *CertificateResourceModel {
+	return &CertificateResourceModel{
+		Id:                        basetypes.NewStringNull(),
+		Name:                      basetypes.NewStringNull(),
+		Certificate:               basetypes.NewStringNull(),
+		PrivateKey:                basetypes.NewStringNull(),
+		Csr:                       basetypes.NewObjectNull(certificateCsrType.AttrTypes),
+		CertificateSigningRequest: basetypes.NewStringNull(),
+		WebUi:                     webUi,
+		Subject:                   basetypes.NewStringNull(),
+		Issuer:                    basetypes.NewStringNull(),
+		SubjectAlternativeNames:   basetypes.NewListNull(types.StringType),
+		NotBefore:                 basetypes.NewStringNull(),
+		NotAfter:                  basetypes.NewStringNull(),
+		SecretsVersion:            secretsVersion,
+	}
+}
+
+// setDetails sets the computed attributes of the certificate.
+func (data *CertificateResourceModel) setDetails(details certificateDetails) {
+	data.Subject = details.Subject
+	data.Issuer = details.Issuer
+	data.SubjectAlternativeNames = details.SubjectAlternativeNames
+	data.NotBefore = details.NotBefore
+	data.NotAfter = details.NotAfter
+}
+
+func (r *CertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_certificate")
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+	tflog.Debug(ctx, "End metadata of resource: nd_certificate")
+}
+
+func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_certificate")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Certificates of Nexus Dashboard and the certificate of the Nexus Dashboard web UI.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The PEM encoded certificate chain, starting with the certificate of ND followed by the intermediate CA certificates. When the `csr` is configured, the certificate is the certificate signed by the CA from the `certificate_signing_request`.",
+			},
+			"private_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The PEM encoded private key of the certificate, required when the `certificate` is uploaded without the `csr`. The private key is write-only and never stored in the state, it is sent to ND when the resource is created and when the `secrets_version` changes.",
+			},
+			"csr": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The subject of the certificate signing request generated by ND. The private key is generated by ND and never leaves ND, the CSR is available in the `certificate_signing_request` attribute.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"common_name": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The common name of the subject, ie the FQDN of ND.",
+					},
+					"organization": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+						MarkdownDescription: "The organization of the subject.",
+					},
+					"organizational_unit": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+						MarkdownDescription: "The organizational unit of the subject.",
+					},
+					"locality": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+						MarkdownDescription: "The locality of the subject.",
+					},
+					"state": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+						MarkdownDescription: "The state or province of the subject.",
+					},
+					"country": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+						MarkdownDescription: "The two-letter country code of the subject.",
+						Validators: []validator.String{
+							stringvalidator.Any(stringvalidator.LengthBetween(0, 0), stringvalidator.LengthBetween(2, 2)),
+						},
+					},
+					"subject_alternative_names": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "The DNS names and IP addresses of the subject alternative names.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"key_type": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("rsa2048"),
+						MarkdownDescription: "The type of the private key generated by ND.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("rsa2048", "rsa4096", "ecdsa256", "ecdsa384"),
+						},
+					},
+				},
+			},
+			"certificate_signing_request": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The PEM encoded certificate signing request generated by ND when the `csr` is configured.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"web_ui": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the certificate is bound to the web UI and the API of ND. Setting the attribute to false does not unbind the certificate, ND always serves a certificate, bind another certificate to unbind it.",
+			},
+			"subject": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The subject of the certificate.",
+			},
+			"issuer": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The issuer of the certificate.",
+			},
+			"subject_alternative_names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The subject alternative names of the certificate.",
+			},
+			"not_before": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The start of the validity of the certificate in RFC 3339 format.",
+			},
+			"not_after": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The expiry of the certificate in RFC 3339 format.",
+			},
+			"secrets_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of the write-only secrets, the secrets are sent to ND when the version changes. The version must be changed when an uploaded `certificate` is changed.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_certificate")
+}
+
+func (r *CertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData *CertificateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() || configData.Certificate.IsUnknown() || configData.PrivateKey.IsUnknown() || configData.Csr.IsUnknown() {
+		return
+	}
+
+	if configData.Certificate.IsNull() && configData.Csr.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate"),
+			"Missing certificate",
+			"One of the 'certificate' or 'csr' attributes is required.",
+		)
+		return
+	}
+
+	if !configData.Csr.IsNull() && !configData.PrivateKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key"),
+			"Invalid private_key",
+			"The 'private_key' attribute is invalid when the 'csr' is configured, the private key is generated by ND.",
+		)
+	} else if configData.Csr.IsNull() && configData.PrivateKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key"),
+			"Missing private_key",
+			"The 'private_key' attribute is required when the 'certificate' is configured without the 'csr'.",
+		)
+	}
+
+	if configData.Certificate.IsNull() && configData.WebUi.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("web_ui"),
+			"Invalid web_ui",
+			"The 'web_ui' attribute can only be true when the 'certificate' is configured, the certificate signing request must be signed before it is bound to the web UI.",
+		)
+	}
+
+	if !configData.Certificate.IsNull() {
+		if _, err := parsePemCertificates(configData.Certificate.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("certificate"),
+				"Invalid certificate",
+				fmt.Sprintf("The 'certificate' attribute must be a PEM encoded certificate chain: %s.", err),
+			)
+		}
+	}
+}
+
+// ModifyPlan sets the computed attributes of the certificate from the planned certificate, the expiry of a rotated
+// certificate is shown in the plan. A rotated certificate is rejected when its private key is not sent with it.
+func (r *CertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planData *CertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() || planData.Certificate.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateData *CertificateResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		validateCertificateSecretsVersion(&resp.Diagnostics, planData, stateData)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	planData.setDetails(getCertificateDetails(ctx, &resp.Diagnostics, planData.Certificate.ValueString()))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &planData)...)
+}
+
+// validateCertificateSecretsVersion returns an error when an uploaded certificate is changed without a change of the
+// secrets version, the private key of the certificate is only sent to ND when the secrets version changes. The private
+// key of a certificate signed from the CSR is kept by ND.
+func validateCertificateSecretsVersion(diags *diag.Diagnostics, planData, stateData *CertificateResourceModel) {
+	if !planData.Csr.IsNull() || planData.Certificate.Equal(stateData.Certificate) || !planData.SecretsVersion.Equal(stateData.SecretsVersion) {
+		return
+	}
+	diags.AddAttributeError(
+		path.Root("secrets_version"),
+		"Missing secrets_version change",
+		"The 'secrets_version' attribute must be changed when the 'certificate' is changed, the 'private_key' of the certificate is only sent to ND when the 'secrets_version' changes.",
+	)
+}
+
+func (r *CertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_certificate")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_certificate")
+}
+
+func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_certificate")
+
+	var planData *CertificateResourceModel
+	var configData *CertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getCertificateJsonPayload(ctx, &resp.Diagnostics, planData, configData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureCertificates)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "POST", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = types.StringValue(planData.Name.ValueString())
+	if planData.WebUi.ValueBool() {
+		bindUICertificate(ctx, &resp.Diagnostics, r.client, planData.Id.ValueString())
+	}
+	getAndSetCertificateAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_certificate with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_certificate")
+	var stateData *CertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_certificate with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetCertificateAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *CertificateResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_certificate with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_certificate")
+
+	var planData *CertificateResourceModel
+	var stateData *CertificateResourceModel
+	var configData *CertificateResourceModel
+
+	// Read Terraform plan data and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_certificate with id '%s'", planData.Id.ValueString()))
+
+	// The secrets are only sent when the secrets version changes, a rotated certificate is uploaded with its private key
+	// and a new secrets version. ND keeps the current private key when it is not provided.
+	secretsData := configData
+	if planData.SecretsVersion.Equal(stateData.SecretsVersion) {
+		secretsData = nil
+	}
+
+	if !planData.Certificate.Equal(stateData.Certificate) || secretsData != nil {
+		jsonPayload := getCertificateJsonPayload(ctx, &resp.Diagnostics, planData, secretsData)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureCertificates)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, planData.Id.ValueString()), "PUT", jsonPayload)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if planData.WebUi.ValueBool() {
+		bindUICertificate(ctx, &resp.Diagnostics, r.client, planData.Id.ValueString())
+	}
+	getAndSetCertificateAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_certificate")
+}
+
+func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_certificate")
+	var stateData *CertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_certificate with id '%s'", stateData.Id.ValueString()))
+
+	// ND always serves a certificate, the certificate of the web UI can only be deleted after another certificate is bound.
+	if getUICertificate(ctx, &resp.Diagnostics, r.client) == stateData.Id.ValueString() {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to delete the certificate '%s'", stateData.Id.ValueString()),
+			"The certificate is bound to the web UI of ND, bind another certificate to the web UI before the certificate is deleted. Use create_before_destroy when the certificate is replaced.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureCertificates)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, stateData.Id.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_certificate with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *CertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_certificate")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_certificate with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_certificate")
+}
+
+// getUICertificate returns the name of the certificate bound to the web UI of ND.
+func getUICertificate(ctx context.Context, diags *diag.Diagnostics, client *client.Client) string {
+	responseData := getClusterConfig(ctx, diags, client, nd.FeatureUICertificate)
+	if diags.HasError() || responseData == nil {
+		return ""
+	}
+	return getResponseString(responseData, "/certificate")
+}
+
+// bindUICertificate binds the certificate to the web UI of ND when another certificate is bound.
+func bindUICertificate(ctx context.Context, diags *diag.Diagnostics, client *client.Client, name string) {
+	payload := gabs.New()
+	setPayloadValue(diags, payload, name, "/certificate")
+	if diags.HasError() {
+		return
+	}
+	putClusterConfig(ctx, diags, client, nd.FeatureUICertificate, payload, payload, false)
+}
+
+func getCertificateJsonPayload(ctx context.Context, diags *diag.Diagnostics, data, configData *CertificateResourceModel) *gabs.Container {
+	payload := gabs.New()
+
+	setPayloadValue(diags, payload, data.Name.ValueString(), "/name")
+	if !data.Certificate.IsNull() {
+		setPayloadValue(diags, payload, data.Certificate.ValueString(), "/certificate")
+	}
+	if configData != nil && !configData.PrivateKey.IsNull() {
+		setPayloadValue(diags, payload, configData.PrivateKey.ValueString(), "/privateKey")
+	}
+
+	if !data.Csr.IsNull() {
+		var csr CertificateCsrModel
+		diags.Append(data.Csr.As(ctx, &csr, basetypes.ObjectAsOptions{})...)
+		subjectAlternativeNames := make([]string, 0)
+		if !csr.SubjectAlternativeNames.IsNull() {
+			diags.Append(csr.SubjectAlternativeNames.ElementsAs(ctx, &subjectAlternativeNames, false)...)
+		}
+		setPayloadValue(diags, payload, csr.CommonName.ValueString(), "/csr/commonName")
+		setPayloadValue(diags, payload, csr.Organization.ValueString(), "/csr/organization")
+		setPayloadValue(diags, payload, csr.OrganizationalUnit.ValueString(), "/csr/organizationalUnit")
+		setPayloadValue(diags, payload, csr.Locality.ValueString(), "/csr/locality")
+		setPayloadValue(diags, payload, csr.State.ValueString(), "/csr/state")
+		setPayloadValue(diags, payload, csr.Country.ValueString(), "/csr/country")
+		setPayloadValue(diags, payload, subjectAlternativeNames, "/csr/subjectAlternativeNames")
+		setPayloadValue(diags, payload, csr.KeyType.ValueString(), "/csr/keyType")
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+func getAndSetCertificateAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *CertificateResourceModel) {
+	apiPath := client.GetPath(ctx, diags, nd.FeatureCertificates)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "GET", nil)
+	stateCertificate, stateWebUi := data.Certificate, data.WebUi
+	*data = *getBaseCertificateResourceModel(basetypes.NewBoolValue(false), data.SecretsVersion)
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		return
+	}
+
+	name := getResponseString(responseData, "/name")
+	data.Id = basetypes.NewStringValue(name)
+	data.Name = basetypes.NewStringValue(name)
+
+	// ND returns the certificate chain in its own format, the configured value is kept when the certificates are the same.
+	if certificate := getResponseString(responseData, "/certificate"); certificate != "" {
+		if samePemCertificates(stateCertificate.ValueString(), certificate) {
+			data.Certificate = stateCertificate
+		} else {
+			data.Certificate = basetypes.NewStringValue(certificate)
+		}
+		data.setDetails(getCertificateDetails(ctx, diags, certificate))
+	}
+
+	if _, err := responseData.JSONPointer("/csr"); err == nil {
+		csr := CertificateCsrModel{
+			CommonName:              basetypes.NewStringValue(getResponseString(responseData, "/csr/commonName")),
+			Organization:            basetypes.NewStringValue(getResponseString(responseData, "/csr/organization")),
+			OrganizationalUnit:      basetypes.NewStringValue(getResponseString(responseData, "/csr/organizationalUnit")),
+			Locality:                basetypes.NewStringValue(getResponseString(responseData, "/csr/locality")),
+			State:                   basetypes.NewStringValue(getResponseString(responseData, "/csr/state")),
+			Country:                 basetypes.NewStringValue(getResponseString(responseData, "/csr/country")),
+			SubjectAlternativeNames: basetypes.NewListNull(types.StringType),
+			KeyType:                 basetypes.NewStringValue(getResponseString(responseData, "/csr/keyType")),
+		}
+		if subjectAlternativeNames := getStringElements(getResponseList(responseData, "/csr/subjectAlternativeNames")); len(subjectAlternativeNames) > 0 {
+			subjectAlternativeNamesList, listDiags := types.ListValueFrom(ctx, types.StringType, subjectAlternativeNames)
+			diags.Append(listDiags...)
+			csr.SubjectAlternativeNames = subjectAlternativeNamesList
+		}
+		csrObject, objectDiags := types.ObjectValueFrom(ctx, certificateCsrType.AttrTypes, csr)
+		diags.Append(objectDiags...)
+		data.Csr = csrObject
+	}
+	if csrPem := getResponseString(responseData, "/csrPem"); csrPem != "" {
+		data.CertificateSigningRequest = basetypes.NewStringValue(csrPem)
+	}
+
+	// The binding is only refreshed when the certificate is expected to be bound or when it is imported, a certificate
+	// which is not managed as the certificate of the web UI is not changed by another certificate bound to the web UI.
+	if stateWebUi.IsNull() || stateWebUi.ValueBool() {
+		data.WebUi = basetypes.NewBoolValue(getUICertificate(ctx, diags, client) == name)
+	}
+}
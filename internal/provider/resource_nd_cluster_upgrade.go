@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultClusterUpgradeTimeout = 4 * time.Hour
+
+// clusterUpgradeId is the ID of the nd_cluster_upgrade resource, the upgrade is a single object of the cluster.
+const clusterUpgradeId = "upgrade"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterUpgradeResource{}
+var _ resource.ResourceWithImportState = &ClusterUpgradeResource{}
+var _ resource.ResourceWithModifyPlan = &ClusterUpgradeResource{}
+
+func NewClusterUpgradeResource() resource.Resource {
+	return &ClusterUpgradeResource{}
+}
+
+// ClusterUpgradeResource defines the resource implementation.
+type ClusterUpgradeResource struct {
+	client *client.Client
+}
+
+// ClusterUpgradeResourceModel describes the resource data model.
+type ClusterUpgradeResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Image          types.String `tfsdk:"image"`
+	Timeout        types.String `tfsdk:"timeout"`
+	TargetVersion  types.String `tfsdk:"target_version"`
+	CurrentVersion types.String `tfsdk:"current_version"`
+	Nodes          types.List   `tfsdk:"nodes"`
+}
+
+var clusterUpgradeNodeType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":    types.StringType,
+	"version": types.StringType,
+	"status":  types.StringType,
+}}
+
+func getBaseClusterUpgradeResourceModel(image, timeout basetypes.StringValue) *ClusterUpgradeResourceModel {
+	return &ClusterUpgradeResourceModel{
+		Id:             basetypes.NewStringNull(),
+		Image:          image,
+		Timeout:        timeout,
+		TargetVersion:  basetypes.NewStringNull(),
+		CurrentVersion: basetypes.NewStringNull(),
+		Nodes:          basetypes.NewListNull(clusterUpgradeNodeType),
+	}
+}
+
+func (r *ClusterUpgradeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_cluster_upgrade")
+	resp.TypeName = req.ProviderTypeName + "_cluster_upgrade"
+	tflog.Debug(ctx, "End metadata of resource: nd_cluster_upgrade")
+}
+
+func (r *ClusterUpgradeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_cluster_upgrade")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the Upgrade of the Nexus Dashboard cluster. The cluster is upgraded when the resource is created and when the image changes, the upgrade cannot be undone when the resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the cluster upgrade.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the firmware image installed on the cluster, see the `nd_firmware_image` resource.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum duration of the upgrade, ie '6h'. Defaults to '4h'.",
+			},
+			"target_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ND version of the last upgrade.",
+			},
+			"current_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ND version running on the cluster.",
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The upgrade status of the nodes of the cluster.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the node.",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ND version running on the node.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The upgrade status of the node.",
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_cluster_upgrade")
+}
+
+func (r *ClusterUpgradeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var planData, stateData *ClusterUpgradeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	}
+
+	if resp.Diagnostics.HasError() || planData.Image.IsUnknown() || (stateData != nil && planData.Image.Equal(stateData.Image)) {
+		return
+	}
+
+	// The image is verified before the upgrade is planned, an upgrade to a missing image would only fail during the apply.
+	if _, err := r.client.Firmware.GetImage(ctx, planData.Image.ValueString()); nd.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("image"),
+			"Firmware image not found",
+			fmt.Sprintf("The firmware image '%s' does not exist in the image repository of ND, upload the image with the nd_firmware_image resource.", planData.Image.ValueString()),
+		)
+	}
+}
+
+func (r *ClusterUpgradeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_cluster_upgrade")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_cluster_upgrade")
+}
+
+func (r *ClusterUpgradeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_cluster_upgrade")
+
+	var planData *ClusterUpgradeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgradeCluster(ctx, &resp.Diagnostics, r.client, planData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = basetypes.NewStringValue(clusterUpgradeId)
+	getAndSetClusterUpgradeAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_cluster_upgrade with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *ClusterUpgradeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_cluster_upgrade")
+	var stateData *ClusterUpgradeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_cluster_upgrade with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetClusterUpgradeAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *ClusterUpgradeResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_cluster_upgrade with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *ClusterUpgradeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_cluster_upgrade")
+
+	var planData *ClusterUpgradeResourceModel
+	var stateData *ClusterUpgradeResourceModel
+
+	// Read Terraform plan data and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_cluster_upgrade with id '%s'", planData.Id.ValueString()))
+
+	// The cluster is only upgraded when the image changes, ie not when only the timeout changes.
+	if !planData.Image.Equal(stateData.Image) {
+		upgradeCluster(ctx, &resp.Diagnostics, r.client, planData)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetClusterUpgradeAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_cluster_upgrade")
+}
+
+func (r *ClusterUpgradeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_cluster_upgrade")
+	var stateData *ClusterUpgradeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_cluster_upgrade with id '%s'", stateData.Id.ValueString()))
+	resp.Diagnostics.AddWarning(
+		"The cluster upgrade cannot be undone",
+		fmt.Sprintf("The cluster upgrade is removed from the Terraform state, the cluster keeps running ND %s.", stateData.CurrentVersion.ValueString()),
+	)
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_cluster_upgrade with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *ClusterUpgradeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_cluster_upgrade")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_cluster_upgrade with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_cluster_upgrade")
+}
+
+// upgradeCluster starts the upgrade of the cluster to the image and waits until the upgrade of all nodes is done.
+func upgradeCluster(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *ClusterUpgradeResourceModel) {
+	timeout := getTimeout(diags, data.Timeout, defaultClusterUpgradeTimeout)
+	if diags.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := client.Firmware.StartUpgrade(ctx, data.Image.ValueString()); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to start the upgrade to the firmware image '%s'", data.Image.ValueString()),
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	_, err := client.Firmware.WaitUpgrade(ctx, func(upgrade *nd.Upgrade) {
+		tflog.Info(ctx, fmt.Sprintf("Upgrade of the ND cluster to '%s': %s", data.Image.ValueString(), upgrade))
+	})
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Upgrade to the firmware image '%s' failed", data.Image.ValueString()),
+			getUpgradeErrorDetail(err),
+		)
+		return
+	}
+
+	// The API paths of the next requests depend on the upgraded version of ND.
+	client.ResetPlatformVersion()
+}
+
+// getUpgradeErrorDetail returns the detail of the diagnostic of an upgrade error, the status of the nodes which did
+// not complete the upgrade are listed one per line.
+func getUpgradeErrorDetail(err error) string {
+	var upgradeErr *nd.UpgradeError
+	if !errors.As(err, &upgradeErr) {
+		return fmt.Sprintf("Error: %s", err)
+	}
+	detail := fmt.Sprintf("Error: the upgrade to %s failed", upgradeErr.Upgrade.TargetVersion)
+	if upgradeErr.Upgrade.Message != "" {
+		detail = fmt.Sprintf("%s: %s", detail, upgradeErr.Upgrade.Message)
+	}
+	nodes := []string{}
+	for _, node := range upgradeErr.Upgrade.Nodes {
+		if !strings.EqualFold(node.Status, nd.UpgradeStatusCompleted) {
+			nodes = append(nodes, fmt.Sprintf("\n  - %s", node.String()))
+		}
+	}
+	if len(nodes) != 0 {
+		detail += "\n\nNodes not upgraded:" + strings.Join(nodes, "")
+	}
+	return detail
+}
+
+func getAndSetClusterUpgradeAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *ClusterUpgradeResourceModel) {
+	upgrade, err := client.Firmware.GetUpgrade(ctx)
+	// The API only returns the image of the last upgrade, the image and timeout are assigned based on the user's configuration settings.
+	image := data.Image
+	*data = *getBaseClusterUpgradeResourceModel(image, data.Timeout)
+	if nd.IsNotFound(err) {
+		return
+	} else if err != nil {
+		diags.AddError(
+			"Unable to read the cluster upgrade",
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(clusterUpgradeId)
+	if image.IsNull() && upgrade.Image != "" {
+		data.Image = basetypes.NewStringValue(upgrade.Image)
+	}
+	data.TargetVersion = basetypes.NewStringValue(upgrade.TargetVersion)
+	data.CurrentVersion = basetypes.NewStringValue(upgrade.CurrentVersion)
+
+	nodes := make([]attr.Value, 0, len(upgrade.Nodes))
+	for _, node := range upgrade.Nodes {
+		nodes = append(nodes, types.ObjectValueMust(clusterUpgradeNodeType.AttrTypes, map[string]attr.Value{
+			"name":    basetypes.NewStringValue(node.Name),
+			"version": basetypes.NewStringValue(node.Version),
+			"status":  basetypes.NewStringValue(node.Status),
+		}))
+	}
+	nodesValue, listDiags := types.ListValue(clusterUpgradeNodeType, nodes)
+	diags.Append(listDiags...)
+	data.Nodes = nodesValue
+}
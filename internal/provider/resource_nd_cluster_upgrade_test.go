@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestClusterUpgrade(t *testing.T) {
+	var started nd.Upgrade
+	statuses := []string{
+		`{"status": "running", "image": "nd-dk9.4.1.2a.iso", "targetVersion": "4.1.2a", "currentVersion": "4.1.1g", "nodes": [{"name": "nd1", "status": "running", "progress": 50}, {"name": "nd2", "status": "pending"}]}`,
+		`{"status": "completed", "image": "nd-dk9.4.1.2a.iso", "targetVersion": "4.1.2a", "currentVersion": "4.1.2a", "nodes": [{"name": "nd1", "version": "4.1.2a", "status": "completed"}, {"name": "nd2", "version": "4.1.2a", "status": "completed"}]}`,
+	}
+	requests, versionRequests := 0, 0
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/version.json":
+			io.WriteString(w, `{"major": 4, "minor": 1, "maintenance": 2, "patch": "a"}`)
+			versionRequests++
+		case r.Method == "POST" && r.URL.Path == "/api/v1/infra/firmware/upgrade":
+			json.NewDecoder(r.Body).Decode(&started)
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == "GET" && r.URL.Path == "/api/v1/infra/firmware/upgrade":
+			io.WriteString(w, statuses[min(requests, len(statuses)-1)])
+			requests++
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseClusterUpgradeResourceModel(basetypes.NewStringValue("nd-dk9.4.1.2a.iso"), basetypes.NewStringValue("1h"))
+	upgradeCluster(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if started.Image != "nd-dk9.4.1.2a.iso" {
+		t.Errorf("unexpected upgrade payload %+v", started)
+	}
+
+	// The version of ND is requested again after the upgrade.
+	version, err := client.PlatformVersion(ctx)
+	if err != nil || version.String() != "4.1.2a" || versionRequests != 1 {
+		t.Errorf("expected the upgraded version to be requested, got %s after %d requests: %v", version, versionRequests, err)
+	}
+
+	data.Id = basetypes.NewStringValue(clusterUpgradeId)
+	getAndSetClusterUpgradeAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.CurrentVersion.ValueString() != "4.1.2a" || data.TargetVersion.ValueString() != "4.1.2a" || len(data.Nodes.Elements()) != 2 {
+		t.Errorf("unexpected current version %s, target version %s and nodes %s", data.CurrentVersion, data.TargetVersion, data.Nodes)
+	}
+
+	// The image of the last upgrade is set when the upgrade is imported.
+	imported := getBaseClusterUpgradeResourceModel(basetypes.NewStringNull(), basetypes.NewStringNull())
+	imported.Id = basetypes.NewStringValue(clusterUpgradeId)
+	getAndSetClusterUpgradeAttributes(ctx, &diags, client, imported)
+	if imported.Image.ValueString() != "nd-dk9.4.1.2a.iso" {
+		t.Errorf("unexpected imported image %s", imported.Image)
+	}
+}
+
+func TestClusterUpgradeFailed(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(http.StatusAccepted)
+		case "GET":
+			io.WriteString(w, `{"status": "failed", "targetVersion": "4.1.2a", "message": "Upgrade aborted", "nodes": [{"name": "nd1", "status": "completed"}, {"name": "nd2", "status": "failed", "message": "Disk full"}, {"name": "nd3", "status": "pending"}]}`)
+		}
+	})
+
+	var diags diag.Diagnostics
+	upgradeCluster(context.Background(), &diags, client, getBaseClusterUpgradeResourceModel(basetypes.NewStringValue("nd-dk9.4.1.2a.iso"), basetypes.NewStringNull()))
+	if !diags.HasError() {
+		t.Fatal("expected an error for the failed upgrade")
+	}
+	expected := "Error: the upgrade to 4.1.2a failed: Upgrade aborted\n\nNodes not upgraded:\n  - nd2 failed: Disk full\n  - nd3 pending"
+	if detail := diags.Errors()[0].Detail(); detail != expected {
+		t.Errorf("error detail = %q, expected %q", detail, expected)
+	}
+}
@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DnsConfigResource{}
+var _ resource.ResourceWithImportState = &DnsConfigResource{}
+
+// dnsConfigId is the ID of the nd_dns_config resource, the DNS configuration is a single object of the cluster.
+const dnsConfigId = "dns"
+
+func NewDnsConfigResource() resource.Resource {
+	return &DnsConfigResource{}
+}
+
+// DnsConfigResource defines the resource implementation.
+type DnsConfigResource struct {
+	client *client.Client
+}
+
+// DnsConfigResourceModel describes the resource data model.
+type DnsConfigResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Providers     types.List   `tfsdk:"providers"`
+	SearchDomains types.List   `tfsdk:"search_domains"`
+}
+
+func getBaseDnsConfigResourceModel() *DnsConfigResourceModel {
+	return &DnsConfigResourceModel{
+		Id:            basetypes.NewStringNull(),
+		Providers:     basetypes.NewListNull(types.StringType),
+		SearchDomains: basetypes.NewListNull(types.StringType),
+	}
+}
+
+func (r *DnsConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_dns_config")
+	resp.TypeName = req.ProviderTypeName + "_dns_config"
+	tflog.Debug(ctx, "End metadata of resource: nd_dns_config")
+}
+
+func (r *DnsConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_dns_config")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the DNS configuration of the Nexus Dashboard cluster. The DNS configuration captured at creation is restored when the resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the DNS configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"providers": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IP addresses of the DNS providers in order of priority.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"search_domains": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "The DNS search domains in order of priority.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_dns_config")
+}
+
+func (r *DnsConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_dns_config")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_dns_config")
+}
+
+func (r *DnsConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_dns_config")
+
+	var planData *DnsConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getDnsConfigJsonPayload(ctx, &resp.Diagnostics, planData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The current configuration is captured before it is changed, it is restored when the resource is destroyed.
+	currentData := putClusterConfig(ctx, &resp.Diagnostics, r.client, nd.FeatureDNSConfig, jsonPayload, jsonPayload, false)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if currentData.Data() != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, previousConfigKey, currentData.Bytes())...)
+	}
+
+	planData.Id = basetypes.NewStringValue(dnsConfigId)
+	getAndSetDnsConfigAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_dns_config with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *DnsConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_dns_config")
+	var stateData *DnsConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_dns_config with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetDnsConfigAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *DnsConfigResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_dns_config with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *DnsConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_dns_config")
+
+	var planData *DnsConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_dns_config with id '%s'", planData.Id.ValueString()))
+
+	jsonPayload := getDnsConfigJsonPayload(ctx, &resp.Diagnostics, planData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	putClusterConfig(ctx, &resp.Diagnostics, r.client, nd.FeatureDNSConfig, jsonPayload, jsonPayload, false)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetDnsConfigAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_dns_config")
+}
+
+func (r *DnsConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_dns_config")
+	var stateData *DnsConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_dns_config with id '%s'", stateData.Id.ValueString()))
+
+	previousConfig, diags := req.Private.GetKey(ctx, previousConfigKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restoreClusterConfig(ctx, &resp.Diagnostics, r.client, nd.FeatureDNSConfig, previousConfig)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_dns_config with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *DnsConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_dns_config")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_dns_config with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_dns_config")
+}
+
+func getDnsConfigJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *DnsConfigResourceModel) *gabs.Container {
+	payload := gabs.New()
+
+	providers := make([]string, 0)
+	diags.Append(data.Providers.ElementsAs(ctx, &providers, false)...)
+	setPayloadValue(diags, payload, providers, "/nameServers")
+
+	searchDomains := make([]string, 0)
+	diags.Append(data.SearchDomains.ElementsAs(ctx, &searchDomains, false)...)
+	setPayloadValue(diags, payload, searchDomains, "/searchDomains")
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+func getAndSetDnsConfigAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *DnsConfigResourceModel) {
+	responseData := getClusterConfig(ctx, diags, client, nd.FeatureDNSConfig)
+	*data = *getBaseDnsConfigResourceModel()
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(dnsConfigId)
+	providers, listDiags := types.ListValueFrom(ctx, types.StringType, getResponseNames(getResponseValue(responseData, "/nameServers")))
+	diags.Append(listDiags...)
+	data.Providers = providers
+	searchDomains, listDiags := types.ListValueFrom(ctx, types.StringType, getResponseNames(getResponseValue(responseData, "/searchDomains")))
+	diags.Append(listDiags...)
+	data.SearchDomains = searchDomains
+}
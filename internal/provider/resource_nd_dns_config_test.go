@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceNdDnsConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdDnsConfigCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_dns_config.test", "id", "dns"),
+					resource.TestCheckResourceAttr("nd_dns_config.test", "providers.#", "1"),
+					resource.TestCheckResourceAttr("nd_dns_config.test", "providers.0", "10.0.0.53"),
+					resource.TestCheckResourceAttr("nd_dns_config.test", "search_domains.#", "0"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_dns_config.test",
+				ImportState:       true,
+				ImportStateId:     "dns",
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdDnsConfigUpdate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_dns_config.test", "providers.#", "2"),
+					resource.TestCheckResourceAttr("nd_dns_config.test", "providers.0", "10.0.0.53"),
+					resource.TestCheckResourceAttr("nd_dns_config.test", "providers.1", "10.0.1.53"),
+					resource.TestCheckResourceAttr("nd_dns_config.test", "search_domains.#", "1"),
+					resource.TestCheckResourceAttr("nd_dns_config.test", "search_domains.0", "example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestDnsConfigReadComparePut(t *testing.T) {
+	current := `{"nameServers": ["10.0.0.53"], "searchDomains": ["example.com"], "lastModified": "2026-01-01T00:00:00Z"}`
+	puts := []string{}
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/infra/clusterConfig/dns" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == "PUT" {
+			body, _ := io.ReadAll(r.Body)
+			puts = append(puts, string(body))
+			current = string(body)
+		}
+		io.WriteString(w, current)
+	})
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseDnsConfigResourceModel()
+	getAndSetDnsConfigAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Id.ValueString() != "dns" || len(data.Providers.Elements()) != 1 || len(data.SearchDomains.Elements()) != 1 {
+		t.Errorf("unexpected id %s, providers %s and search domains %s", data.Id, data.Providers, data.SearchDomains)
+	}
+
+	// The configuration is not sent when it matches the current configuration.
+	payload := getDnsConfigJsonPayload(ctx, &diags, data)
+	previous := putClusterConfig(ctx, &diags, client, nd.FeatureDNSConfig, payload, payload, false)
+	if diags.HasError() || len(puts) != 0 || getResponseString(previous, "/lastModified") == "" {
+		t.Fatalf("expected no configuration to be sent and the current configuration to be returned, got %v and %s: %v", puts, previous, diags)
+	}
+
+	// The current configuration is only read once the path is unlocked by the other changes of the configuration.
+	payload = gabs.New()
+	setPayloadValue(&diags, payload, []string{"10.0.1.53"}, "/nameServers")
+	setPayloadValue(&diags, payload, []string{}, "/searchDomains")
+	unlock := client.LockPath("/api/v1/infra/clusterConfig/dns")
+	done := make(chan *gabs.Container)
+	go func() {
+		done <- putClusterConfig(ctx, &diags, client, nd.FeatureDNSConfig, payload, payload, false)
+	}()
+	select {
+	case <-done:
+		t.Fatal("expected the configuration to be read and sent after the path is unlocked")
+	case <-time.After(50 * time.Millisecond):
+	}
+	current = `{"nameServers": ["10.0.1.53"], "searchDomains": ["example.com"]}`
+	unlock()
+	<-done
+	if diags.HasError() || len(puts) != 1 || puts[0] != `{"nameServers":["10.0.1.53"],"searchDomains":[]}` {
+		t.Fatalf("unexpected configuration sent %v: %v", puts, diags)
+	}
+
+	// The configuration captured before the changes is restored.
+	restoreClusterConfig(ctx, &diags, client, nd.FeatureDNSConfig, previous.Bytes())
+	if diags.HasError() || len(puts) != 2 || puts[1] != previous.String() {
+		t.Fatalf("expected the previous configuration to be restored, got %v: %v", puts, diags)
+	}
+
+	restoreClusterConfig(ctx, &diags, client, nd.FeatureDNSConfig, nil)
+	if diags.HasError() || diags.WarningsCount() != 1 || len(puts) != 2 {
+		t.Errorf("expected a warning without configuration sent when no configuration was captured, got %v: %v", puts, diags)
+	}
+}
+
+const testConfigResourceNdDnsConfigCreate = `
+resource "nd_dns_config" "test" {
+  providers = ["10.0.0.53"]
+}
+`
+
+const testConfigResourceNdDnsConfigUpdate = `
+resource "nd_dns_config" "test" {
+  providers      = ["10.0.0.53", "10.0.1.53"]
+  search_domains = ["example.com"]
+}
+`
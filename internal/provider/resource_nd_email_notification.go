@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EmailNotificationResource{}
+var _ resource.ResourceWithImportState = &EmailNotificationResource{}
+
+// emailNotificationCategories maps the alert categories of the resource to the alert categories of ND.
+var emailNotificationCategories = map[string]string{
+	"cluster":        "cluster",
+	"nodes":          "nodes",
+	"services":       "services",
+	"fabrics":        "fabrics",
+	"backup_restore": "backupRestore",
+	"firmware":       "firmware",
+	"security":       "security",
+}
+
+func NewEmailNotificationResource() resource.Resource {
+	return &EmailNotificationResource{}
+}
+
+// EmailNotificationResource defines the resource implementation.
+type EmailNotificationResource struct {
+	client *client.Client
+}
+
+// EmailNotificationResourceModel describes the resource data model.
+type EmailNotificationResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Recipients  types.Set    `tfsdk:"recipients"`
+	Categories  types.Set    `tfsdk:"categories"`
+	Severities  types.Set    `tfsdk:"severities"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+func getBaseEmailNotificationResourceModel() *EmailNotificationResourceModel {
+	return &EmailNotificationResourceModel{
+		Id:          basetypes.NewStringNull(),
+		Name:        basetypes.NewStringNull(),
+		Description: basetypes.NewStringNull(),
+		Recipients:  basetypes.NewSetNull(types.StringType),
+		Categories:  basetypes.NewSetNull(types.StringType),
+		Severities:  basetypes.NewSetNull(types.StringType),
+		Enabled:     basetypes.NewBoolNull(),
+	}
+}
+
+func (r *EmailNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_email_notification")
+	resp.TypeName = req.ProviderTypeName + "_email_notification"
+	tflog.Debug(ctx, "End metadata of resource: nd_email_notification")
+}
+
+func (r *EmailNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_email_notification")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Email Notifications of the alerts of Nexus Dashboard.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the email notification.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the email notification.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The description of the email notification.",
+			},
+			"recipients": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses of the recipients of the alerts.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(emailAddressRegex, "must be an email address")),
+				},
+			},
+			"categories": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The categories of the alerts sent to the recipients.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("cluster", "nodes", "services", "fabrics", "backup_restore", "firmware", "security")),
+				},
+			},
+			"severities": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The severities of the alerts sent to the recipients.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("critical", "major", "minor", "warning", "info")),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the alerts are sent to the recipients.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_email_notification")
+}
+
+func (r *EmailNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_email_notification")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_email_notification")
+}
+
+func (r *EmailNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_email_notification")
+
+	var data *EmailNotificationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getEmailNotificationJsonPayload(ctx, &resp.Diagnostics, data)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureEmailNotifications)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "POST", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(data.Name.ValueString())
+	getAndSetEmailNotificationAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_email_notification with id '%s'", data.Id.ValueString()))
+}
+
+func (r *EmailNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_email_notification")
+	var data *EmailNotificationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_email_notification with id '%s'", data.Id.ValueString()))
+
+	getAndSetEmailNotificationAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	if data.Id.IsNull() {
+		var emptyData *EmailNotificationResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_email_notification with id '%s'", data.Id.ValueString()))
+}
+
+func (r *EmailNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_email_notification")
+
+	var data *EmailNotificationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_email_notification with id '%s'", data.Id.ValueString()))
+
+	jsonPayload := getEmailNotificationJsonPayload(ctx, &resp.Diagnostics, data)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureEmailNotifications)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetEmailNotificationAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "End update of resource nd_email_notification")
+}
+
+func (r *EmailNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_email_notification")
+	var data *EmailNotificationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_email_notification with id '%s'", data.Id.ValueString()))
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureEmailNotifications)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_email_notification with id '%s'", data.Id.ValueString()))
+}
+
+func (r *EmailNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_email_notification")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_email_notification with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_email_notification")
+}
+
+func getEmailNotificationJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *EmailNotificationResourceModel) *gabs.Container {
+	payload := gabs.New()
+
+	setPayloadValue(diags, payload, data.Name.ValueString(), "/name")
+	setPayloadValue(diags, payload, data.Description.ValueString(), "/description")
+	setPayloadValue(diags, payload, data.Enabled.ValueBool(), "/enabled")
+
+	recipients := make([]string, 0)
+	diags.Append(data.Recipients.ElementsAs(ctx, &recipients, false)...)
+	setPayloadValue(diags, payload, recipients, "/recipients")
+
+	categories := make([]string, 0)
+	diags.Append(data.Categories.ElementsAs(ctx, &categories, false)...)
+	for index, category := range categories {
+		categories[index] = emailNotificationCategories[category]
+	}
+	setPayloadValue(diags, payload, categories, "/categories")
+
+	severities := make([]string, 0)
+	diags.Append(data.Severities.ElementsAs(ctx, &severities, false)...)
+	setPayloadValue(diags, payload, severities, "/severities")
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+func getAndSetEmailNotificationAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *EmailNotificationResourceModel) {
+	apiPath := client.GetPath(ctx, diags, nd.FeatureEmailNotifications)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "GET", nil)
+	*data = *getBaseEmailNotificationResourceModel()
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		return
+	}
+
+	name := getResponseString(responseData, "/name")
+	data.Id = basetypes.NewStringValue(name)
+	data.Name = basetypes.NewStringValue(name)
+	data.Description = basetypes.NewStringValue(getResponseString(responseData, "/description"))
+	data.Enabled = basetypes.NewBoolValue(getResponseValue(responseData, "/enabled") == true)
+
+	recipients, setDiags := types.SetValueFrom(ctx, types.StringType, getStringElements(getResponseList(responseData, "/recipients")))
+	diags.Append(setDiags...)
+	data.Recipients = recipients
+
+	categories := make([]string, 0)
+	for _, value := range getStringElements(getResponseList(responseData, "/categories")) {
+		for category, ndCategory := range emailNotificationCategories {
+			if value == ndCategory {
+				categories = append(categories, category)
+			}
+		}
+	}
+	categoriesSet, setDiags := types.SetValueFrom(ctx, types.StringType, categories)
+	diags.Append(setDiags...)
+	data.Categories = categoriesSet
+
+	severities, setDiags := types.SetValueFrom(ctx, types.StringType, getStringElements(getResponseList(responseData, "/severities")))
+	diags.Append(setDiags...)
+	data.Severities = severities
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceNdEmailNotification(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdEmailNotificationCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_email_notification.test", "id", "terraform_email_notification"),
+					resource.TestCheckResourceAttr("nd_email_notification.test", "name", "terraform_email_notification"),
+					resource.TestCheckResourceAttr("nd_email_notification.test", "description", ""),
+					resource.TestCheckResourceAttr("nd_email_notification.test", "recipients.#", "1"),
+					resource.TestCheckResourceAttr("nd_email_notification.test", "categories.#", "1"),
+					resource.TestCheckResourceAttr("nd_email_notification.test", "severities.#", "1"),
+					resource.TestCheckResourceAttr("nd_email_notification.test", "enabled", "true"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_email_notification.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdEmailNotificationUpdate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_email_notification.test", "description", "Terraform email notification"),
+					resource.TestCheckResourceAttr("nd_email_notification.test", "recipients.#", "2"),
+					resource.TestCheckResourceAttr("nd_email_notification.test", "categories.#", "2"),
+					resource.TestCheckResourceAttr("nd_email_notification.test", "severities.#", "2"),
+					resource.TestCheckResourceAttr("nd_email_notification.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmailNotificationAttributes(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/emailNotifications/noc":
+			io.WriteString(w, `{"name": "noc", "description": "NOC", "recipients": ["noc@example.com", "oncall@example.com"], "categories": ["cluster", "backupRestore"], "severities": ["critical", "major"], "enabled": true}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseEmailNotificationResourceModel()
+	data.Id = basetypes.NewStringValue("noc")
+	getAndSetEmailNotificationAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	categories := []string{}
+	diags.Append(data.Categories.ElementsAs(ctx, &categories, false)...)
+	if len(data.Recipients.Elements()) != 2 || len(data.Severities.Elements()) != 2 || strings.Join(categories, ",") != "cluster,backup_restore" || !data.Enabled.ValueBool() {
+		t.Errorf("unexpected recipients %s, categories %s, severities %s and enabled %s", data.Recipients, data.Categories, data.Severities, data.Enabled)
+	}
+
+	// The categories of the resource are mapped to the categories of ND.
+	payload := getEmailNotificationJsonPayload(ctx, &diags, data)
+	if diags.HasError() || !strings.Contains(payload.String(), `"categories":["cluster","backupRestore"]`) || !strings.Contains(payload.String(), `"severities":["critical","major"]`) {
+		t.Errorf("unexpected payload %s: %v", payload, diags)
+	}
+
+	data.Id = basetypes.NewStringValue("unknown_notification")
+	getAndSetEmailNotificationAttributes(ctx, &diags, client, data)
+	if diags.HasError() || !data.Id.IsNull() {
+		t.Errorf("expected a null id for an unknown email notification, got %s: %v", data.Id, diags)
+	}
+}
+
+const testConfigResourceNdEmailNotificationCreate = `
+resource "nd_email_notification" "test" {
+  name       = "terraform_email_notification"
+  recipients = ["noc@example.com"]
+  categories = ["cluster"]
+  severities = ["critical"]
+}
+`
+
+const testConfigResourceNdEmailNotificationUpdate = `
+resource "nd_email_notification" "test" {
+  name        = "terraform_email_notification"
+  description = "Terraform email notification"
+  recipients  = ["noc@example.com", "oncall@example.com"]
+  categories  = ["cluster", "backup_restore"]
+  severities  = ["critical", "major"]
+  enabled     = false
+}
+`
@@ -0,0 +1,490 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EventExportResource{}
+var _ resource.ResourceWithImportState = &EventExportResource{}
+var _ resource.ResourceWithValidateConfig = &EventExportResource{}
+
+// eventExportCategories maps the categories of the resource to the categories of ND.
+var eventExportCategories = map[string]string{
+	"events":     "events",
+	"audit_logs": "auditLogs",
+}
+
+// eventExportTypeAttributes are the destination attributes used by each type of event export.
+var eventExportTypeAttributes = map[string][]string{
+	"syslog":  {"syslog_destination"},
+	"webhook": {"url", "token"},
+	"kafka":   {"brokers", "topic"},
+}
+
+func NewEventExportResource() resource.Resource {
+	return &EventExportResource{}
+}
+
+// EventExportResource defines the resource implementation.
+type EventExportResource struct {
+	client *client.Client
+}
+
+// EventExportResourceModel describes the resource data model.
+type EventExportResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Type              types.String `tfsdk:"type"`
+	Categories        types.Set    `tfsdk:"categories"`
+	Severity          types.String `tfsdk:"severity"`
+	SyslogDestination types.String `tfsdk:"syslog_destination"`
+	Url               types.String `tfsdk:"url"`
+	Token             types.String `tfsdk:"token"`
+	Brokers           types.List   `tfsdk:"brokers"`
+	Topic             types.String `tfsdk:"topic"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	SecretsVersion    types.Int64  `tfsdk:"secrets_version"`
+}
+
+func getBaseEventExportResourceModel(secretsVersion basetypes.Int64Value) *EventExportResourceModel {
+	return &EventExportResourceModel{
+		Id:                basetypes.NewStringNull(),
+		Name:              basetypes.NewStringNull(),
+		Description:       basetypes.NewStringNull(),
+		Type:              basetypes.NewStringNull(),
+		Categories:        basetypes.NewSetNull(types.StringType),
+		Severity:          basetypes.NewStringNull(),
+		SyslogDestination: basetypes.NewStringNull(),
+		Url:               basetypes.NewStringNull(),
+		Token:             basetypes.NewStringNull(),
+		Brokers:           basetypes.NewListNull(types.StringType),
+		Topic:             basetypes.NewStringNull(),
+		Enabled:           basetypes.NewBoolNull(),
+		SecretsVersion:    secretsVersion,
+	}
+}
+
+func (r *EventExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_event_export")
+	resp.TypeName = req.ProviderTypeName + "_event_export"
+	tflog.Debug(ctx, "End metadata of resource: nd_event_export")
+}
+
+func (r *EventExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_event_export")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Event Exports of the events and audit logs of Nexus Dashboard to external collectors.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the event export.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the event export.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The description of the event export.",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of the external collector, the events are sent to a syslog destination, posted to a webhook or published to a Kafka topic.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("syslog", "webhook", "kafka"),
+				},
+			},
+			"categories": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The categories of the exported records.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("events", "audit_logs")),
+				},
+			},
+			"severity": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("warning"),
+				MarkdownDescription: "The minimum severity of the exported events, the audit logs are exported regardless of the severity.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("critical", "major", "minor", "warning", "info"),
+				},
+			},
+			"syslog_destination": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the syslog destination of the events, required by the `syslog` type. See the `nd_syslog_destination` resource.",
+			},
+			"url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The URL of the webhook receiving the events, required by the `webhook` type.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http or https URL"),
+				},
+			},
+			"token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The bearer token used to authenticate to the webhook of the `webhook` type. The token is write-only and never stored in the state, it is sent to ND when the event export is created and when the `secrets_version` changes.",
+			},
+			"brokers": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The addresses of the Kafka brokers in the `host:port` format, required by the `kafka` type.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"topic": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The Kafka topic of the events, required by the `kafka` type.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the events are exported.",
+			},
+			"secrets_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of the write-only secrets, the secrets are sent to ND when the version changes.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_event_export")
+}
+
+func (r *EventExportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData *EventExportResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() || configData.Type.IsUnknown() || configData.Type.IsNull() {
+		return
+	}
+
+	exportType := configData.Type.ValueString()
+	destinations := map[string]attr.Value{
+		"syslog_destination": configData.SyslogDestination,
+		"url":                configData.Url,
+		"token":              configData.Token,
+		"brokers":            configData.Brokers,
+		"topic":              configData.Topic,
+	}
+	for name, value := range destinations {
+		valid := slices.Contains(eventExportTypeAttributes[exportType], name)
+		// The token of the webhook type is optional, webhooks do not always require authentication.
+		required := valid && name != "token"
+		if required && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				fmt.Sprintf("Missing %s", name),
+				fmt.Sprintf("The '%s' attribute is required when the 'type' is '%s'.", name, exportType),
+			)
+		} else if !valid && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				fmt.Sprintf("Invalid %s", name),
+				fmt.Sprintf("The '%s' attribute is invalid when the 'type' is '%s'.", name, exportType),
+			)
+		}
+	}
+}
+
+func (r *EventExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_event_export")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_event_export")
+}
+
+func (r *EventExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_event_export")
+
+	var planData *EventExportResourceModel
+	var configData *EventExportResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getEventExportJsonPayload(ctx, &resp.Diagnostics, planData, configData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureEventExports)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "POST", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = types.StringValue(planData.Name.ValueString())
+	getAndSetEventExportAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_event_export with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *EventExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_event_export")
+	var stateData *EventExportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_event_export with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetEventExportAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *EventExportResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_event_export with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *EventExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_event_export")
+
+	var planData *EventExportResourceModel
+	var stateData *EventExportResourceModel
+	var configData *EventExportResourceModel
+
+	// Read Terraform plan data and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_event_export with id '%s'", planData.Id.ValueString()))
+
+	// The secrets are only sent when the secrets version changes, ND keeps the current secrets when they are not provided.
+	secretsData := configData
+	if planData.SecretsVersion.Equal(stateData.SecretsVersion) {
+		secretsData = nil
+	}
+
+	jsonPayload := getEventExportJsonPayload(ctx, &resp.Diagnostics, planData, secretsData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureEventExports)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, planData.Id.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetEventExportAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_event_export")
+}
+
+func (r *EventExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_event_export")
+	var stateData *EventExportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_event_export with id '%s'", stateData.Id.ValueString()))
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureEventExports)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, stateData.Id.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_event_export with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *EventExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_event_export")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_event_export with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_event_export")
+}
+
+// getEventExportJsonPayload returns the payload of the event export, the write-only token is read from the
+// configuration and is omitted when the configuration is nil.
+func getEventExportJsonPayload(ctx context.Context, diags *diag.Diagnostics, data, configData *EventExportResourceModel) *gabs.Container {
+	payload := gabs.New()
+
+	setPayloadValue(diags, payload, data.Name.ValueString(), "/name")
+	setPayloadValue(diags, payload, data.Description.ValueString(), "/description")
+	setPayloadValue(diags, payload, data.Type.ValueString(), "/type")
+	setPayloadValue(diags, payload, data.Severity.ValueString(), "/severity")
+	setPayloadValue(diags, payload, data.Enabled.ValueBool(), "/enabled")
+
+	categories := make([]string, 0)
+	diags.Append(data.Categories.ElementsAs(ctx, &categories, false)...)
+	for index, category := range categories {
+		categories[index] = eventExportCategories[category]
+	}
+	setPayloadValue(diags, payload, categories, "/categories")
+
+	switch data.Type.ValueString() {
+	case "syslog":
+		setPayloadValue(diags, payload, data.SyslogDestination.ValueString(), "/syslogDestination")
+	case "webhook":
+		setPayloadValue(diags, payload, data.Url.ValueString(), "/url")
+		if configData != nil && !configData.Token.IsNull() && !configData.Token.IsUnknown() {
+			setPayloadValue(diags, payload, configData.Token.ValueString(), "/token")
+		}
+	case "kafka":
+		brokers := make([]string, 0)
+		diags.Append(data.Brokers.ElementsAs(ctx, &brokers, false)...)
+		setPayloadValue(diags, payload, brokers, "/brokers")
+		setPayloadValue(diags, payload, data.Topic.ValueString(), "/topic")
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+func getAndSetEventExportAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *EventExportResourceModel) {
+	apiPath := client.GetPath(ctx, diags, nd.FeatureEventExports)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "GET", nil)
+	// The API does not return the token, the secrets_version is assigned based on the user's configuration settings.
+	*data = *getBaseEventExportResourceModel(data.SecretsVersion)
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		return
+	}
+
+	name := getResponseString(responseData, "/name")
+	exportType := getResponseString(responseData, "/type")
+	data.Id = basetypes.NewStringValue(name)
+	data.Name = basetypes.NewStringValue(name)
+	data.Description = basetypes.NewStringValue(getResponseString(responseData, "/description"))
+	data.Type = basetypes.NewStringValue(exportType)
+	data.Severity = basetypes.NewStringValue(getResponseString(responseData, "/severity"))
+	data.Enabled = basetypes.NewBoolValue(getResponseValue(responseData, "/enabled") == true)
+
+	categories := make([]string, 0)
+	for _, value := range getStringElements(getResponseList(responseData, "/categories")) {
+		for category, ndCategory := range eventExportCategories {
+			if value == ndCategory {
+				categories = append(categories, category)
+			}
+		}
+	}
+	categoriesSet, setDiags := types.SetValueFrom(ctx, types.StringType, categories)
+	diags.Append(setDiags...)
+	data.Categories = categoriesSet
+
+	// Only the destination attributes of the type are set, the attributes of the other types are kept null.
+	switch exportType {
+	case "syslog":
+		data.SyslogDestination = basetypes.NewStringValue(getResponseString(responseData, "/syslogDestination"))
+	case "webhook":
+		data.Url = basetypes.NewStringValue(getResponseString(responseData, "/url"))
+	case "kafka":
+		brokers, listDiags := types.ListValueFrom(ctx, types.StringType, getStringElements(getResponseList(responseData, "/brokers")))
+		diags.Append(listDiags...)
+		data.Brokers = brokers
+		data.Topic = basetypes.NewStringValue(getResponseString(responseData, "/topic"))
+	}
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceNdEventExport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdEventExportCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_event_export.test", "id", "terraform_event_export"),
+					resource.TestCheckResourceAttr("nd_event_export.test", "name", "terraform_event_export"),
+					resource.TestCheckResourceAttr("nd_event_export.test", "description", ""),
+					resource.TestCheckResourceAttr("nd_event_export.test", "type", "syslog"),
+					resource.TestCheckResourceAttr("nd_event_export.test", "categories.#", "2"),
+					resource.TestCheckResourceAttr("nd_event_export.test", "severity", "warning"),
+					resource.TestCheckResourceAttr("nd_event_export.test", "syslog_destination", "terraform_syslog"),
+					resource.TestCheckResourceAttr("nd_event_export.test", "enabled", "true"),
+					resource.TestCheckNoResourceAttr("nd_event_export.test", "url"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_event_export.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdEventExportUpdate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_event_export.test", "description", "Terraform event export"),
+					resource.TestCheckResourceAttr("nd_event_export.test", "type", "webhook"),
+					resource.TestCheckResourceAttr("nd_event_export.test", "categories.#", "1"),
+					resource.TestCheckResourceAttr("nd_event_export.test", "categories.0", "audit_logs"),
+					resource.TestCheckResourceAttr("nd_event_export.test", "url", "https://collector.example.com/nd"),
+					resource.TestCheckResourceAttr("nd_event_export.test", "secrets_version", "1"),
+					resource.TestCheckNoResourceAttr("nd_event_export.test", "syslog_destination"),
+					resource.TestCheckNoResourceAttr("nd_event_export.test", "token"),
+				),
+			},
+		},
+	})
+}
+
+// Validate Type Errors
+func TestAccResourceNdEventExportError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfigResourceNdEventExportMissingTopicError,
+				ExpectError: regexp.MustCompile("The 'topic' attribute is required when the 'type' is 'kafka'"),
+			},
+			{
+				Config:      testConfigResourceNdEventExportInvalidUrlError,
+				ExpectError: regexp.MustCompile("The 'url' attribute is invalid when the 'type' is 'syslog'"),
+			},
+		},
+	})
+}
+
+func TestEventExportAttributes(t *testing.T) {
+	var body []byte
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/eventExports/webhook_export":
+			if r.Method == "PUT" {
+				body, _ = io.ReadAll(r.Body)
+			}
+			io.WriteString(w, `{"name": "webhook_export", "type": "webhook", "categories": ["events", "auditLogs"], "severity": "major", "url": "https://collector.example.com/nd", "enabled": true}`)
+		case "/api/v1/infra/eventExports/kafka_export":
+			io.WriteString(w, `{"name": "kafka_export", "type": "kafka", "categories": ["auditLogs"], "severity": "warning", "brokers": ["kafka1:9093", "kafka2:9093"], "topic": "nd-audit", "enabled": true}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseEventExportResourceModel(basetypes.NewInt64Value(1))
+	data.Id = basetypes.NewStringValue("webhook_export")
+	getAndSetEventExportAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	categories := []string{}
+	diags.Append(data.Categories.ElementsAs(ctx, &categories, false)...)
+	if len(categories) != 2 || data.Url.ValueString() != "https://collector.example.com/nd" || !data.Brokers.IsNull() || !data.SyslogDestination.IsNull() {
+		t.Errorf("unexpected categories %s, url %s, brokers %s and syslog destination %s", data.Categories, data.Url, data.Brokers, data.SyslogDestination)
+	}
+	if data.SecretsVersion.ValueInt64() != 1 || !data.Token.IsNull() {
+		t.Errorf("expected the secrets version to be kept and the token to be null, got %s and %s", data.SecretsVersion, data.Token)
+	}
+
+	// The token is only sent when the configuration is provided.
+	payload := getEventExportJsonPayload(ctx, &diags, data, nil)
+	if payload.Exists("token") || payload.Exists("brokers") || payload.Path("url").Data() != "https://collector.example.com/nd" {
+		t.Errorf("unexpected payload %s", payload)
+	}
+	configData := getBaseEventExportResourceModel(basetypes.NewInt64Value(2))
+	configData.Token = basetypes.NewStringValue("collector_token")
+	payload = getEventExportJsonPayload(ctx, &diags, data, configData)
+	client.DoRestRequest(ctx, &diags, "/api/v1/infra/eventExports/webhook_export", "PUT", payload)
+	sent, err := gabs.ParseJSON(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent.Path("token").Data() != "collector_token" || len(getStringElements(getResponseList(sent, "/categories"))) != 2 {
+		t.Errorf("unexpected payload %s", sent)
+	}
+
+	// The categories of ND are mapped to the categories of the resource.
+	data.Id = basetypes.NewStringValue("kafka_export")
+	getAndSetEventExportAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	categories = []string{}
+	diags.Append(data.Categories.ElementsAs(ctx, &categories, false)...)
+	if len(categories) != 1 || categories[0] != "audit_logs" || len(data.Brokers.Elements()) != 2 || data.Topic.ValueString() != "nd-audit" || !data.Url.IsNull() {
+		t.Errorf("unexpected categories %s, brokers %s, topic %s and url %s", data.Categories, data.Brokers, data.Topic, data.Url)
+	}
+	if payload := getEventExportJsonPayload(ctx, &diags, data, configData); payload.Exists("token") || !strings.Contains(payload.String(), `"categories":["auditLogs"]`) {
+		t.Errorf("unexpected payload %s", payload)
+	}
+
+	data.Id = basetypes.NewStringValue("unknown_export")
+	getAndSetEventExportAttributes(ctx, &diags, client, data)
+	if diags.HasError() || !data.Id.IsNull() {
+		t.Errorf("expected a null id for an unknown event export, got %s: %v", data.Id, diags)
+	}
+}
+
+const testConfigResourceNdEventExportCreate = `
+resource "nd_syslog_destination" "test" {
+  name = "terraform_syslog"
+  host = "192.168.10.160"
+}
+
+resource "nd_event_export" "test" {
+  name               = "terraform_event_export"
+  type               = "syslog"
+  categories         = ["events", "audit_logs"]
+  syslog_destination = nd_syslog_destination.test.name
+}
+`
+
+const testConfigResourceNdEventExportUpdate = `
+resource "nd_event_export" "test" {
+  name            = "terraform_event_export"
+  description     = "Terraform event export"
+  type            = "webhook"
+  categories      = ["audit_logs"]
+  url             = "https://collector.example.com/nd"
+  token           = "terraform_token"
+  secrets_version = 1
+}
+`
+
+const testConfigResourceNdEventExportMissingTopicError = `
+resource "nd_event_export" "test" {
+  name       = "terraform_error"
+  type       = "kafka"
+  categories = ["events"]
+  brokers    = ["kafka1.example.com:9093"]
+}
+`
+
+const testConfigResourceNdEventExportInvalidUrlError = `
+resource "nd_event_export" "test" {
+  name               = "terraform_error"
+  type               = "syslog"
+  categories         = ["events"]
+  syslog_destination = "terraform_syslog"
+  url                = "https://collector.example.com/nd"
+}
+`
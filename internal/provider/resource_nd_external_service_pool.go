@@ -0,0 +1,430 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ExternalServicePoolResource{}
+var _ resource.ResourceWithImportState = &ExternalServicePoolResource{}
+var _ resource.ResourceWithValidateConfig = &ExternalServicePoolResource{}
+var _ resource.ResourceWithModifyPlan = &ExternalServicePoolResource{}
+
+// externalServicePoolNetworks are the objects of the network types in the external service pools and cluster network configurations.
+var externalServicePoolNetworks = map[string]string{
+	"management": "managementNetwork",
+	"data":       "dataNetwork",
+}
+
+func NewExternalServicePoolResource() resource.Resource {
+	return &ExternalServicePoolResource{}
+}
+
+// ExternalServicePoolResource defines the resource implementation.
+type ExternalServicePoolResource struct {
+	client *client.Client
+}
+
+// ExternalServicePoolResourceModel describes the resource data model.
+type ExternalServicePoolResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	NetworkType  types.String `tfsdk:"network_type"`
+	IpFamily     types.String `tfsdk:"ip_family"`
+	Ips          types.Set    `tfsdk:"ips"`
+	Subnet       types.String `tfsdk:"subnet"`
+	AllocatedIps types.Map    `tfsdk:"allocated_ips"`
+}
+
+func getBaseExternalServicePoolResourceModel() *ExternalServicePoolResourceModel {
+	return &ExternalServicePoolResourceModel{
+		Id:           basetypes.NewStringNull(),
+		NetworkType:  basetypes.NewStringNull(),
+		IpFamily:     basetypes.NewStringNull(),
+		Ips:          basetypes.NewSetNull(types.StringType),
+		Subnet:       basetypes.NewStringNull(),
+		AllocatedIps: basetypes.NewMapNull(types.StringType),
+	}
+}
+
+func (r *ExternalServicePoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_external_service_pool")
+	resp.TypeName = req.ProviderTypeName + "_external_service_pool"
+	tflog.Debug(ctx, "End metadata of resource: nd_external_service_pool")
+}
+
+func (r *ExternalServicePoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_external_service_pool")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the External Service Pools of persistent IP addresses of the Nexus Dashboard cluster, each pool is the list of IP addresses of a network type and IP family.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the external service pool in the format `{network_type}:{ip_family}`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The network of the cluster of the external service pool.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("management", "data"),
+				},
+			},
+			"ip_family": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP family of the addresses of the external service pool.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("ipv4", "ipv6"),
+				},
+			},
+			"ips": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The persistent IP addresses of the external service pool, the addresses must be in the subnet of the network of the cluster.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"subnet": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The subnet of the network of the cluster for the IP family.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allocated_ips": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IP addresses of the pool which are allocated to services, mapped to the name of the service.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_external_service_pool")
+}
+
+func (r *ExternalServicePoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData *ExternalServicePoolResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() || configData.IpFamily.IsUnknown() || configData.Ips.IsNull() || configData.Ips.IsUnknown() {
+		return
+	}
+
+	for _, ip := range configData.Ips.Elements() {
+		if ip.IsUnknown() {
+			continue
+		}
+		value := ip.(basetypes.StringValue).ValueString()
+		if err := validateExternalServicePoolIp(value, configData.IpFamily.ValueString(), nil); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ips"),
+				"Invalid external service pool IP address",
+				fmt.Sprintf("The IP address '%s' %s.", value, err),
+			)
+		}
+	}
+}
+
+// ModifyPlan validates the IP addresses against the subnet of the network of the cluster reported by ND.
+func (r *ExternalServicePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var planData *ExternalServicePoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() || planData.NetworkType.IsUnknown() || planData.IpFamily.IsUnknown() || planData.Ips.IsUnknown() {
+		return
+	}
+
+	subnet := getExternalServicePoolSubnet(ctx, &resp.Diagnostics, r.client, planData.NetworkType.ValueString(), planData.IpFamily.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, network, err := net.ParseCIDR(subnet)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid cluster subnet",
+			fmt.Sprintf("The %s %s subnet '%s' reported by ND is not a valid subnet.", planData.NetworkType.ValueString(), planData.IpFamily.ValueString(), subnet),
+		)
+		return
+	}
+
+	for _, ip := range planData.Ips.Elements() {
+		if ip.IsUnknown() {
+			continue
+		}
+		value := ip.(basetypes.StringValue).ValueString()
+		if err := validateExternalServicePoolIp(value, planData.IpFamily.ValueString(), network); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ips"),
+				"Invalid external service pool IP address",
+				fmt.Sprintf("The IP address '%s' %s.", value, err),
+			)
+		}
+	}
+}
+
+func (r *ExternalServicePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_external_service_pool")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_external_service_pool")
+}
+
+func (r *ExternalServicePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_external_service_pool")
+
+	var planData *ExternalServicePoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setExternalServicePoolIps(ctx, &resp.Diagnostics, r.client, planData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = basetypes.NewStringValue(getExternalServicePoolId(planData.NetworkType.ValueString(), planData.IpFamily.ValueString()))
+	getAndSetExternalServicePoolAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_external_service_pool with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *ExternalServicePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_external_service_pool")
+	var stateData *ExternalServicePoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_external_service_pool with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetExternalServicePoolAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *ExternalServicePoolResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_external_service_pool with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *ExternalServicePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_external_service_pool")
+
+	var planData *ExternalServicePoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_external_service_pool with id '%s'", planData.Id.ValueString()))
+
+	setExternalServicePoolIps(ctx, &resp.Diagnostics, r.client, planData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetExternalServicePoolAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_external_service_pool")
+}
+
+func (r *ExternalServicePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_external_service_pool")
+	var stateData *ExternalServicePoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_external_service_pool with id '%s'", stateData.Id.ValueString()))
+
+	// The pool is emptied, ND rejects the removal of the IP addresses which are allocated to services.
+	pointer := getExternalServicePoolPointer(stateData.NetworkType.ValueString(), stateData.IpFamily.ValueString())
+	updateClusterConfigList(ctx, &resp.Diagnostics, r.client, nd.FeatureExternalServicePools, pointer, func(ips []string) []string {
+		return []string{}
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_external_service_pool with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *ExternalServicePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_external_service_pool")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_external_service_pool with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_external_service_pool")
+}
+
+func getExternalServicePoolId(networkType, ipFamily string) string {
+	return fmt.Sprintf("%s:%s", networkType, ipFamily)
+}
+
+// getExternalServicePoolPointer returns the JSON pointer of the IP addresses of the pool in the external service pools configuration.
+func getExternalServicePoolPointer(networkType, ipFamily string) string {
+	return fmt.Sprintf("/%s/%s", externalServicePoolNetworks[networkType], ipFamily)
+}
+
+// getExternalServicePoolSubnet returns the subnet of the IP family of the network of the cluster reported by ND.
+func getExternalServicePoolSubnet(ctx context.Context, diags *diag.Diagnostics, client *client.Client, networkType, ipFamily string) string {
+	responseData := getClusterConfig(ctx, diags, client, nd.FeatureClusterNetworkConfig)
+	if diags.HasError() {
+		return ""
+	}
+	return getResponseString(responseData, fmt.Sprintf("/%s/%sSubnet", externalServicePoolNetworks[networkType], ipFamily))
+}
+
+// validateExternalServicePoolIp returns an error when the IP address is not an address of the IP family or is not in the
+// network, the network is not validated when it is nil.
+func validateExternalServicePoolIp(value, ipFamily string, network *net.IPNet) error {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return fmt.Errorf("must be an IP address")
+	}
+	if (ip.To4() != nil) != (ipFamily == "ipv4") {
+		return fmt.Errorf("must be an %s address", strings.Replace(ipFamily, "ip", "IP", 1))
+	}
+	if network != nil && !network.Contains(ip) {
+		return fmt.Errorf("must be in the subnet '%s' of the cluster", network)
+	}
+	return nil
+}
+
+// setExternalServicePoolIps replaces the IP addresses of the pool in the external service pools configuration, the
+// other pools are preserved.
+func setExternalServicePoolIps(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *ExternalServicePoolResourceModel) {
+	ips := make([]string, 0)
+	diags.Append(data.Ips.ElementsAs(ctx, &ips, false)...)
+	if diags.HasError() {
+		return
+	}
+	sort.Strings(ips)
+
+	pointer := getExternalServicePoolPointer(data.NetworkType.ValueString(), data.IpFamily.ValueString())
+	updateClusterConfigList(ctx, diags, client, nd.FeatureExternalServicePools, pointer, func(currentIps []string) []string {
+		// The order of the addresses is not significant, the current order is kept when the addresses are not changed.
+		sortedIps := append([]string{}, currentIps...)
+		sort.Strings(sortedIps)
+		if strings.Join(sortedIps, ",") == strings.Join(ips, ",") {
+			return currentIps
+		}
+		return ips
+	})
+}
+
+func getAndSetExternalServicePoolAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *ExternalServicePoolResourceModel) {
+	id := data.Id.ValueString()
+	networkType, ipFamily, found := strings.Cut(id, ":")
+	*data = *getBaseExternalServicePoolResourceModel()
+	if _, ok := externalServicePoolNetworks[networkType]; !found || !ok || (ipFamily != "ipv4" && ipFamily != "ipv6") {
+		diags.AddError(
+			"Invalid external service pool ID",
+			fmt.Sprintf("The ID '%s' must be in the format '{network_type}:{ip_family}' with the network type 'management' or 'data' and the IP family 'ipv4' or 'ipv6'.", id),
+		)
+		return
+	}
+
+	responseData := getClusterConfig(ctx, diags, client, nd.FeatureExternalServicePools)
+	if diags.HasError() || responseData == nil {
+		return
+	}
+
+	ips := getStringElements(getResponseList(responseData, getExternalServicePoolPointer(networkType, ipFamily)))
+	if len(ips) == 0 {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(getExternalServicePoolId(networkType, ipFamily))
+	data.NetworkType = basetypes.NewStringValue(networkType)
+	data.IpFamily = basetypes.NewStringValue(ipFamily)
+	ipsSet, setDiags := types.SetValueFrom(ctx, types.StringType, ips)
+	diags.Append(setDiags...)
+	data.Ips = ipsSet
+
+	// The allocations of all pools are returned by ND, only the allocations of the addresses of the pool are kept.
+	allocatedIps := map[string]string{}
+	for _, allocation := range getResponseItems(responseData.S("allocations")) {
+		ip := getResponseString(allocation, "/ip")
+		for _, poolIp := range ips {
+			if ip == poolIp {
+				allocatedIps[ip] = getResponseString(allocation, "/service")
+			}
+		}
+	}
+	allocatedIpsMap, mapDiags := types.MapValueFrom(ctx, types.StringType, allocatedIps)
+	diags.Append(mapDiags...)
+	data.AllocatedIps = allocatedIpsMap
+
+	data.Subnet = basetypes.NewStringValue(getExternalServicePoolSubnet(ctx, diags, client, networkType, ipFamily))
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceNdExternalServicePool(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdExternalServicePoolCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_external_service_pool.test", "id", "management:ipv4"),
+					resource.TestCheckResourceAttr("nd_external_service_pool.test", "network_type", "management"),
+					resource.TestCheckResourceAttr("nd_external_service_pool.test", "ip_family", "ipv4"),
+					resource.TestCheckResourceAttr("nd_external_service_pool.test", "ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr("nd_external_service_pool.test", "ips.*", "192.168.10.200"),
+					resource.TestCheckResourceAttrSet("nd_external_service_pool.test", "subnet"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_external_service_pool.test",
+				ImportState:       true,
+				ImportStateId:     "management:ipv4",
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdExternalServicePoolUpdate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_external_service_pool.test", "ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr("nd_external_service_pool.test", "ips.*", "192.168.10.200"),
+					resource.TestCheckTypeSetElemAttr("nd_external_service_pool.test", "ips.*", "192.168.10.201"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateExternalServicePoolIp(t *testing.T) {
+	_, network, _ := net.ParseCIDR("192.168.10.0/24")
+	tests := []struct {
+		ip          string
+		ipFamily    string
+		network     *net.IPNet
+		expectError bool
+	}{
+		{"192.168.10.200", "ipv4", network, false},
+		{"192.168.11.200", "ipv4", network, true},
+		{"192.168.11.200", "ipv4", nil, false},
+		{"2001:db8::10", "ipv4", nil, true},
+		{"2001:db8::10", "ipv6", nil, false},
+		{"192.168.10.200", "ipv6", nil, true},
+		{"192.168.10.0/24", "ipv4", nil, true},
+	}
+	for _, test := range tests {
+		if err := validateExternalServicePoolIp(test.ip, test.ipFamily, test.network); (err != nil) != test.expectError {
+			t.Errorf("validateExternalServicePoolIp(%s, %s, %v) error = %v, expected error %t", test.ip, test.ipFamily, test.network, err, test.expectError)
+		}
+	}
+}
+
+func TestExternalServicePoolAttributes(t *testing.T) {
+	pools := `{"managementNetwork": {"ipv4": ["192.168.10.200"], "ipv6": ["2001:db8::10"]}, "dataNetwork": {"ipv4": ["10.0.0.200"]}, "allocations": [{"ip": "192.168.10.200", "service": "insights"}, {"ip": "10.0.0.200", "service": "fabric-controller"}]}`
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/clusterConfig/network":
+			io.WriteString(w, `{"managementNetwork": {"ipv4Subnet": "192.168.10.0/24", "ipv6Subnet": "2001:db8::/64"}, "dataNetwork": {"ipv4Subnet": "10.0.0.0/24"}}`)
+		case "/api/v1/infra/clusterConfig/externalServicePools":
+			if r.Method == "PUT" {
+				body, _ := io.ReadAll(r.Body)
+				pools = string(body)
+			}
+			io.WriteString(w, pools)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseExternalServicePoolResourceModel()
+	data.Id = basetypes.NewStringValue("management:ipv4")
+	getAndSetExternalServicePoolAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.NetworkType.ValueString() != "management" || data.IpFamily.ValueString() != "ipv4" || data.Subnet.ValueString() != "192.168.10.0/24" {
+		t.Errorf("unexpected network type %s, ip family %s and subnet %s", data.NetworkType, data.IpFamily, data.Subnet)
+	}
+	allocatedIps := map[string]string{}
+	diags.Append(data.AllocatedIps.ElementsAs(ctx, &allocatedIps, false)...)
+	if len(allocatedIps) != 1 || allocatedIps["192.168.10.200"] != "insights" {
+		t.Errorf("unexpected allocated IPs %v", allocatedIps)
+	}
+
+	// The IP addresses of the pool are replaced, the other pools and allocations are preserved.
+	data.Ips = types.SetValueMust(types.StringType, []attr.Value{basetypes.NewStringValue("192.168.10.201"), basetypes.NewStringValue("192.168.10.200")})
+	setExternalServicePoolIps(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	poolsData, err := gabs.ParseJSON([]byte(pools))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ips := getStringElements(getResponseList(poolsData, "/managementNetwork/ipv4"))
+	if len(ips) != 2 || ips[0] != "192.168.10.200" || ips[1] != "192.168.10.201" {
+		t.Errorf("unexpected IP addresses %v", ips)
+	}
+	if len(getResponseList(poolsData, "/managementNetwork/ipv6")) != 1 || len(getResponseList(poolsData, "/dataNetwork/ipv4")) != 1 || len(getResponseItems(poolsData.S("allocations"))) != 2 {
+		t.Errorf("expected the other pools to be preserved, got %s", pools)
+	}
+
+	data.Id = basetypes.NewStringValue("data:ipv6")
+	getAndSetExternalServicePoolAttributes(ctx, &diags, client, data)
+	if diags.HasError() || !data.Id.IsNull() {
+		t.Errorf("expected a null id for an empty pool, got %s: %v", data.Id, diags)
+	}
+}
+
+const testConfigResourceNdExternalServicePoolCreate = `
+resource "nd_external_service_pool" "test" {
+  network_type = "management"
+  ip_family    = "ipv4"
+  ips          = ["192.168.10.200"]
+}
+`
+
+const testConfigResourceNdExternalServicePoolUpdate = `
+resource "nd_external_service_pool" "test" {
+  network_type = "management"
+  ip_family    = "ipv4"
+  ips          = ["192.168.10.200", "192.168.10.201"]
+}
+`
@@ -0,0 +1,399 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultFirmwareImageTimeout = 1 * time.Hour
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirmwareImageResource{}
+var _ resource.ResourceWithImportState = &FirmwareImageResource{}
+var _ resource.ResourceWithValidateConfig = &FirmwareImageResource{}
+
+func NewFirmwareImageResource() resource.Resource {
+	return &FirmwareImageResource{}
+}
+
+// FirmwareImageResource defines the resource implementation.
+type FirmwareImageResource struct {
+	client *client.Client
+}
+
+// FirmwareImageResourceModel describes the resource data model.
+type FirmwareImageResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	SourcePath types.String `tfsdk:"source_path"`
+	SourceUrl  types.String `tfsdk:"source_url"`
+	Checksum   types.String `tfsdk:"checksum"`
+	Timeout    types.String `tfsdk:"timeout"`
+	Name       types.String `tfsdk:"name"`
+	Version    types.String `tfsdk:"version"`
+	Size       types.Int64  `tfsdk:"size"`
+	Status     types.String `tfsdk:"status"`
+}
+
+func getBaseFirmwareImageResourceModel(sourcePath, sourceUrl, timeout basetypes.StringValue) *FirmwareImageResourceModel {
+	return &FirmwareImageResourceModel{
+		Id:         basetypes.NewStringNull(),
+		SourcePath: sourcePath,
+		SourceUrl:  sourceUrl,
+		Checksum:   basetypes.NewStringNull(),
+		Timeout:    timeout,
+		Name:       basetypes.NewStringNull(),
+		Version:    basetypes.NewStringNull(),
+		Size:       basetypes.NewInt64Null(),
+		Status:     basetypes.NewStringNull(),
+	}
+}
+
+// requiresReplaceIfSourceChanged replaces the image when the source changes, the source is unknown after an import.
+var requiresReplaceIfSourceChanged = stringplanmodifier.RequiresReplaceIf(
+	func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		resp.RequiresReplace = !req.StateValue.IsNull()
+	},
+	"The image is replaced when the source changes, unless the source is unknown because the image was imported.",
+	"The image is replaced when the source changes, unless the source is unknown because the image was imported.",
+)
+
+func (r *FirmwareImageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_firmware_image")
+	resp.TypeName = req.ProviderTypeName + "_firmware_image"
+	tflog.Debug(ctx, "End metadata of resource: nd_firmware_image")
+}
+
+func (r *FirmwareImageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_firmware_image")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Firmware Images of the image repository of Nexus Dashboard.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the firmware image.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The local path of the ISO file uploaded to ND. The file is uploaded in chunks and an interrupted upload of the same file is resumed. Exactly one of `source_path` or `source_url` must be provided.",
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceIfSourceChanged,
+				},
+			},
+			"source_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The HTTP or HTTPS URL of the ISO file downloaded by ND. Exactly one of `source_path` or `source_url` must be provided.",
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceIfSourceChanged,
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The hex encoded SHA-256 checksum of the ISO file. The checksum of the file and of the image stored by ND are verified against the checksum when it is provided.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9a-f]{64}$`), "must be a lower case hex encoded SHA-256 checksum"),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum duration of the upload or download of the image, ie '2h'. Defaults to '1h'.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the firmware image.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ND version of the firmware image.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The size of the firmware image in bytes.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the firmware image.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_firmware_image")
+}
+
+func (r *FirmwareImageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData *FirmwareImageResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() || configData.SourcePath.IsUnknown() || configData.SourceUrl.IsUnknown() {
+		return
+	}
+
+	if configData.SourcePath.IsNull() == configData.SourceUrl.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			tfpath.Root("source_path"),
+			"Invalid firmware image source",
+			"Exactly one of 'source_path' or 'source_url' must be provided.",
+		)
+	}
+
+	if !configData.SourceUrl.IsNull() {
+		if sourceUrl, err := url.Parse(configData.SourceUrl.ValueString()); err != nil || (sourceUrl.Scheme != "http" && sourceUrl.Scheme != "https") || sourceUrl.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				tfpath.Root("source_url"),
+				"Invalid source_url",
+				fmt.Sprintf("The source URL '%s' must be an HTTP or HTTPS URL.", configData.SourceUrl.ValueString()),
+			)
+		}
+	}
+
+	if !configData.Timeout.IsUnknown() {
+		getTimeout(&resp.Diagnostics, configData.Timeout, defaultFirmwareImageTimeout)
+	}
+}
+
+func (r *FirmwareImageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_firmware_image")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_firmware_image")
+}
+
+func (r *FirmwareImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_firmware_image")
+
+	var planData *FirmwareImageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	timeout := getTimeout(&resp.Diagnostics, planData.Timeout, defaultFirmwareImageTimeout)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	name := createFirmwareImage(ctx, &resp.Diagnostics, r.client, planData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = basetypes.NewStringValue(name)
+	getAndSetFirmwareImageAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_firmware_image with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *FirmwareImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_firmware_image")
+	var stateData *FirmwareImageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_firmware_image with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetFirmwareImageAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *FirmwareImageResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_firmware_image with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *FirmwareImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_firmware_image")
+
+	var planData *FirmwareImageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_firmware_image with id '%s'", planData.Id.ValueString()))
+
+	// The image itself cannot be updated, only the attributes used to create the image are changed in place.
+	getAndSetFirmwareImageAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_firmware_image")
+}
+
+func (r *FirmwareImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_firmware_image")
+	var stateData *FirmwareImageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_firmware_image with id '%s'", stateData.Id.ValueString()))
+	if err := r.client.Firmware.DeleteImage(ctx, stateData.Id.ValueString()); err != nil && !nd.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to delete the firmware image '%s'", stateData.Id.ValueString()),
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_firmware_image with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *FirmwareImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_firmware_image")
+	resource.ImportStatePassthroughID(ctx, tfpath.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_firmware_image with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_firmware_image")
+}
+
+// createFirmwareImage uploads the local file or lets ND download the URL and returns the name of the image.
+func createFirmwareImage(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *FirmwareImageResourceModel) string {
+	checksum := data.Checksum.ValueString()
+
+	if !data.SourcePath.IsNull() {
+		progress := getTransferProgress(ctx, fmt.Sprintf("Upload of the firmware image '%s'", data.SourcePath.ValueString()), nil)
+		image, err := client.Firmware.UploadImageFile(ctx, data.SourcePath.ValueString(), checksum, progress)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Unable to upload the firmware image '%s'", data.SourcePath.ValueString()),
+				getFirmwareImageErrorDetail(err),
+			)
+			if image != nil && errors.Is(err, nd.ErrChecksumMismatch) {
+				// The corrupted image is not kept by ND.
+				client.Firmware.DeleteImage(ctx, image.Name)
+			}
+			return ""
+		}
+		return image.Name
+	}
+
+	task, err := client.Firmware.ImportImage(ctx, data.SourceUrl.ValueString(), checksum)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to download the firmware image '%s'", data.SourceUrl.ValueString()),
+			fmt.Sprintf("Error: %s", err),
+		)
+		return ""
+	}
+	waitForTask(ctx, diags, client, task, fmt.Sprintf("Download of firmware image '%s'", data.SourceUrl.ValueString()), nil)
+	if diags.HasError() {
+		return ""
+	}
+
+	// ND names the downloaded image after the file name of the URL.
+	sourceUrl, _ := url.Parse(data.SourceUrl.ValueString())
+	name := path.Base(sourceUrl.Path)
+	if checksum != "" {
+		image, err := client.Firmware.GetImage(ctx, name)
+		if err == nil && image.Checksum != "" && !strings.EqualFold(image.Checksum, checksum) {
+			diags.AddError(
+				fmt.Sprintf("Unable to download the firmware image '%s'", data.SourceUrl.ValueString()),
+				fmt.Sprintf("The checksum of the image stored by ND is %s, expected %s. Verify the source URL and the checksum.", image.Checksum, checksum),
+			)
+			client.Firmware.DeleteImage(ctx, name)
+			return ""
+		}
+	}
+	return name
+}
+
+func getFirmwareImageErrorDetail(err error) string {
+	if errors.Is(err, nd.ErrChecksumMismatch) {
+		return fmt.Sprintf("Error: %s. Verify the file and the checksum.", err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Sprintf("Error: %s. The upload is resumed at the next apply, increase the timeout to upload the image at once.", err)
+	}
+	return fmt.Sprintf("Error: %s", err)
+}
+
+func getAndSetFirmwareImageAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *FirmwareImageResourceModel) {
+	image, err := client.Firmware.GetImage(ctx, data.Id.ValueString())
+	// The API does not return the source of the image, the source and timeout are assigned based on the user's configuration settings.
+	*data = *getBaseFirmwareImageResourceModel(data.SourcePath, data.SourceUrl, data.Timeout)
+	if nd.IsNotFound(err) {
+		return
+	} else if err != nil {
+		diags.AddError(
+			"Unable to read the firmware image",
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(image.Name)
+	data.Name = basetypes.NewStringValue(image.Name)
+	data.Version = basetypes.NewStringValue(image.Version)
+	data.Size = basetypes.NewInt64Value(image.Size)
+	data.Status = basetypes.NewStringValue(image.Status)
+	data.Checksum = basetypes.NewStringValue(strings.ToLower(image.Checksum))
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFirmwareImageUpload(t *testing.T) {
+	content := "nd firmware image"
+	sum := sha256.Sum256([]byte(content))
+	checksum := hex.EncodeToString(sum[:])
+	sourcePath := filepath.Join(t.TempDir(), "nd-dk9.4.1.2a.iso")
+	if err := os.WriteFile(sourcePath, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var received []byte
+	deleted := []string{}
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/infra/firmware/uploads":
+			io.WriteString(w, `{"items": []}`)
+		case r.Method == "POST" && r.URL.Path == "/api/v1/infra/firmware/uploads":
+			io.WriteString(w, `{"id": "upload1"}`)
+		case r.Method == "PUT" && r.URL.Path == "/api/v1/infra/firmware/uploads/upload1":
+			body, _ := io.ReadAll(r.Body)
+			received = append(received, body...)
+			fmt.Fprintf(w, `{"id": "upload1", "offset": %d}`, len(received))
+		case r.Method == "POST" && r.URL.Path == "/api/v1/infra/firmware/uploads/upload1/complete":
+			sum := sha256.Sum256(received)
+			json.NewEncoder(w).Encode(nd.FirmwareImage{Name: "nd-dk9.4.1.2a.iso", Checksum: hex.EncodeToString(sum[:])})
+		case r.Method == "GET" && r.URL.Path == "/api/v1/infra/firmware/images/nd-dk9.4.1.2a.iso":
+			fmt.Fprintf(w, `{"name": "nd-dk9.4.1.2a.iso", "version": "4.1.2a", "size": %d, "sha256": "%s", "status": "ready"}`, len(content), strings.ToUpper(checksum))
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.Path)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseFirmwareImageResourceModel(basetypes.NewStringValue(sourcePath), basetypes.NewStringNull(), basetypes.NewStringNull())
+	data.Checksum = basetypes.NewStringValue(checksum)
+	data.Id = basetypes.NewStringValue(createFirmwareImage(ctx, &diags, client, data))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if string(received) != content {
+		t.Errorf("received %q, expected the content of the file", received)
+	}
+
+	getAndSetFirmwareImageAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Name.ValueString() != "nd-dk9.4.1.2a.iso" || data.Version.ValueString() != "4.1.2a" || data.Checksum.ValueString() != checksum || data.SourcePath.ValueString() != sourcePath {
+		t.Errorf("unexpected name %s, version %s, checksum %s and source path %s", data.Name, data.Version, data.Checksum, data.SourcePath)
+	}
+
+	// The file is not uploaded when its checksum differs from the configured checksum.
+	received = nil
+	data.Checksum = basetypes.NewStringValue(strings.Repeat("0", 64))
+	createFirmwareImage(ctx, &diags, client, data)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "checksum mismatch") || received != nil {
+		t.Errorf("expected a checksum mismatch before the upload, got %v", diags)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("expected no image to be deleted, got %v", deleted)
+	}
+}
+
+func TestFirmwareImageDownload(t *testing.T) {
+	deleted := []string{}
+	var imported map[string]string
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v1/infra/firmware/images":
+			json.NewDecoder(r.Body).Decode(&imported)
+			io.WriteString(w, `{"id": "task1", "status": "pending"}`)
+		case r.Method == "GET" && r.URL.Path == "/api/v1/infra/tasks/task1":
+			io.WriteString(w, `{"id": "task1", "status": "completed"}`)
+		case r.Method == "GET" && r.URL.Path == "/api/v1/infra/firmware/images/nd-dk9.4.1.2a.iso":
+			fmt.Fprintf(w, `{"name": "nd-dk9.4.1.2a.iso", "sha256": "%s"}`, strings.Repeat("a", 64))
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.Path)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseFirmwareImageResourceModel(basetypes.NewStringNull(), basetypes.NewStringValue("https://images.example.com/nd/nd-dk9.4.1.2a.iso"), basetypes.NewStringNull())
+	if name := createFirmwareImage(ctx, &diags, client, data); diags.HasError() || name != "nd-dk9.4.1.2a.iso" {
+		t.Fatalf("createFirmwareImage() = %s, expected the name of the image: %v", name, diags)
+	}
+	if imported["url"] != data.SourceUrl.ValueString() || imported["sha256"] != "" {
+		t.Errorf("unexpected import payload %v", imported)
+	}
+
+	// The downloaded image is deleted when its checksum differs from the configured checksum.
+	data.Checksum = basetypes.NewStringValue(strings.Repeat("b", 64))
+	createFirmwareImage(ctx, &diags, client, data)
+	if !diags.HasError() || len(deleted) != 1 || deleted[0] != "/api/v1/infra/firmware/images/nd-dk9.4.1.2a.iso" {
+		t.Errorf("expected a checksum error and the deletion of the image, got %v and %v", diags, deleted)
+	}
+}
@@ -0,0 +1,497 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LocalUserResource{}
+var _ resource.ResourceWithImportState = &LocalUserResource{}
+var _ resource.ResourceWithIdentity = &LocalUserResource{}
+
+// localUserSecurityDomainType is the type of the elements of the security_domains set.
+var localUserSecurityDomainType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"roles": types.SetType{ElemType: types.StringType},
+	},
+}
+
+func NewLocalUserResource() resource.Resource {
+	return &LocalUserResource{}
+}
+
+// LocalUserResource defines the resource implementation.
+type LocalUserResource struct {
+	client *client.Client
+}
+
+// LocalUserResourceModel describes the resource data model.
+type LocalUserResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	LoginId         types.String `tfsdk:"login_id"`
+	FirstName       types.String `tfsdk:"first_name"`
+	LastName        types.String `tfsdk:"last_name"`
+	Email           types.String `tfsdk:"email"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	AccountStatus   types.String `tfsdk:"account_status"`
+	SecurityDomains types.Set    `tfsdk:"security_domains"`
+}
+
+// LocalUserIdentityModel describes the resource identity data model, the login ID is also the import ID of the resource.
+type LocalUserIdentityModel struct {
+	LoginId types.String `tfsdk:"login_id"`
+}
+
+// LocalUserSecurityDomainModel describes the roles of the user in a security domain.
+type LocalUserSecurityDomainModel struct {
+	Name  types.String `tfsdk:"name"`
+	Roles types.Set    `tfsdk:"roles"`
+}
+
+func getBaseLocalUserResourceModel(passwordVersion basetypes.Int64Value) *LocalUserResourceModel {
+	return &LocalUserResourceModel{
+		Id:              basetypes.NewStringNull(),
+		LoginId:         basetypes.NewStringNull(),
+		FirstName:       basetypes.NewStringNull(),
+		LastName:        basetypes.NewStringNull(),
+		Email:           basetypes.NewStringNull(),
+		Password:        basetypes.NewStringNull(),
+		PasswordVersion: passwordVersion,
+		AccountStatus:   basetypes.NewStringNull(),
+		SecurityDomains: basetypes.NewSetNull(localUserSecurityDomainType),
+	}
+}
+
+func (r *LocalUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_local_user")
+	resp.TypeName = req.ProviderTypeName + "_local_user"
+	tflog.Debug(ctx, "End metadata of resource: nd_local_user")
+}
+
+func (r *LocalUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_local_user")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Local Users for Nexus Dashboard",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the local user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"login_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The login ID of the local user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The first name of the local user.",
+			},
+			"last_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The last name of the local user.",
+			},
+			"email": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The email address of the local user.",
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The password of the local user. The password is write-only and never stored in the state, it is sent to ND when the user is created and when the `password_version` changes.",
+			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of the password, changing the version updates the password of the local user in ND.",
+			},
+			"account_status": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("active"),
+				MarkdownDescription: "The status of the local user account, an inactive user cannot log in to ND.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "inactive"),
+				},
+			},
+			"security_domains": schema.SetNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The roles of the local user in each security domain.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the security domain, ie 'all'.",
+						},
+						"roles": schema.SetAttribute{
+							Required:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The names of the roles of the local user in the security domain, ie 'fabric-admin'.",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_local_user")
+}
+
+func (r *LocalUserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"login_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The login ID of the local user.",
+			},
+		},
+	}
+}
+
+func (r *LocalUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_local_user")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_local_user")
+}
+
+func (r *LocalUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_local_user")
+
+	var planData *LocalUserResourceModel
+	var configData *LocalUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getLocalUserJsonPayload(ctx, &resp.Diagnostics, planData, configData.Password)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureLocalUsers)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "POST", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = types.StringValue(planData.LoginId.ValueString())
+	getAndSetLocalUserAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, LocalUserIdentityModel{LoginId: planData.LoginId})...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_local_user with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *LocalUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_local_user")
+	var stateData *LocalUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_local_user with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetLocalUserAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *LocalUserResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, LocalUserIdentityModel{LoginId: stateData.LoginId})...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_local_user with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *LocalUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_local_user")
+
+	var planData *LocalUserResourceModel
+	var stateData *LocalUserResourceModel
+	var configData *LocalUserResourceModel
+
+	// Read Terraform plan data and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_local_user with id '%s'", planData.Id.ValueString()))
+
+	// The password is only sent when the password version changes, ND keeps the current password when it is not provided.
+	password := basetypes.NewStringNull()
+	if !planData.PasswordVersion.Equal(stateData.PasswordVersion) {
+		password = configData.Password
+	}
+
+	jsonPayload := getLocalUserJsonPayload(ctx, &resp.Diagnostics, planData, password)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureLocalUsers)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, planData.Id.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetLocalUserAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, LocalUserIdentityModel{LoginId: planData.LoginId})...)
+	tflog.Debug(ctx, "End update of resource nd_local_user")
+}
+
+func (r *LocalUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_local_user")
+	var stateData *LocalUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_local_user with id '%s'", stateData.Id.ValueString()))
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureLocalUsers)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, stateData.Id.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_local_user with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *LocalUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_local_user")
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("login_id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_local_user with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_local_user")
+}
+
+func getLocalUserJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *LocalUserResourceModel, password basetypes.StringValue) *gabs.Container {
+	payload := gabs.New()
+
+	setPayloadValue(diags, payload, data.LoginId.ValueString(), "/loginID")
+	if !data.FirstName.IsNull() && !data.FirstName.IsUnknown() {
+		setPayloadValue(diags, payload, data.FirstName.ValueString(), "/firstName")
+	}
+	if !data.LastName.IsNull() && !data.LastName.IsUnknown() {
+		setPayloadValue(diags, payload, data.LastName.ValueString(), "/lastName")
+	}
+	if !data.Email.IsNull() && !data.Email.IsUnknown() {
+		setPayloadValue(diags, payload, data.Email.ValueString(), "/email")
+	}
+	if !password.IsNull() && !password.IsUnknown() {
+		setPayloadValue(diags, payload, password.ValueString(), "/password")
+	}
+	if !data.AccountStatus.IsNull() && !data.AccountStatus.IsUnknown() {
+		setPayloadValue(diags, payload, getLocalUserAccountStatusPayload(data.AccountStatus.ValueString()), "/accountStatus")
+	}
+
+	if !data.SecurityDomains.IsNull() && !data.SecurityDomains.IsUnknown() {
+		var securityDomains []LocalUserSecurityDomainModel
+		diags.Append(data.SecurityDomains.ElementsAs(ctx, &securityDomains, false)...)
+		domains := map[string]interface{}{}
+		for _, securityDomain := range securityDomains {
+			roles := make([]string, 0)
+			diags.Append(securityDomain.Roles.ElementsAs(ctx, &roles, false)...)
+			domains[securityDomain.Name.ValueString()] = map[string]interface{}{"roles": roles}
+		}
+		setPayloadValue(diags, payload, map[string]interface{}{"domains": domains}, "/rbac")
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+// getLocalUserAccountStatusPayload returns the account status in the capitalized form used by ND, ie "Active".
+func getLocalUserAccountStatusPayload(status string) string {
+	if status == "" {
+		return status
+	}
+	return strings.ToUpper(status[:1]) + status[1:]
+}
+
+func getAndSetLocalUserAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *LocalUserResourceModel) {
+	apiPath := client.GetPath(ctx, diags, nd.FeatureLocalUsers)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "GET", nil)
+	// The API does not return the password, the password_version is assigned based on the user's configuration settings.
+	*data = *getBaseLocalUserResourceModel(data.PasswordVersion)
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() != nil {
+		userData := getLocalUserDataModel(ctx, diags, responseData)
+		data.Id = userData.Id
+		data.LoginId = userData.LoginId
+		data.FirstName = userData.FirstName
+		data.LastName = userData.LastName
+		data.Email = userData.Email
+		data.AccountStatus = userData.AccountStatus
+		data.SecurityDomains = userData.SecurityDomains
+	} else {
+		data.Id = basetypes.NewStringNull()
+	}
+}
+
+// getLocalUserDataModel returns the attributes of the local user returned by ND.
+func getLocalUserDataModel(ctx context.Context, diags *diag.Diagnostics, responseData *gabs.Container) LocalUserDataModel {
+	data := LocalUserDataModel{
+		Id:              basetypes.NewStringNull(),
+		LoginId:         basetypes.NewStringNull(),
+		FirstName:       basetypes.NewStringValue(""),
+		LastName:        basetypes.NewStringValue(""),
+		Email:           basetypes.NewStringValue(""),
+		AccountStatus:   basetypes.NewStringValue("active"),
+		SecurityDomains: basetypes.NewSetValueMust(localUserSecurityDomainType, []attr.Value{}),
+	}
+
+	if value, ok := getResponseValue(responseData, "/loginID").(string); ok {
+		data.Id = basetypes.NewStringValue(value)
+		data.LoginId = basetypes.NewStringValue(value)
+	}
+	if value, ok := getResponseValue(responseData, "/firstName").(string); ok {
+		data.FirstName = basetypes.NewStringValue(value)
+	}
+	if value, ok := getResponseValue(responseData, "/lastName").(string); ok {
+		data.LastName = basetypes.NewStringValue(value)
+	}
+	if value, ok := getResponseValue(responseData, "/email").(string); ok {
+		data.Email = basetypes.NewStringValue(value)
+	}
+	if value, ok := getResponseValue(responseData, "/accountStatus").(string); ok && value != "" {
+		data.AccountStatus = basetypes.NewStringValue(strings.ToLower(value))
+	}
+
+	if domains, ok := getResponseValue(responseData, "/rbac/domains").(map[string]interface{}); ok {
+		names := make([]string, 0, len(domains))
+		for name := range domains {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		securityDomains := make([]LocalUserSecurityDomainModel, 0, len(names))
+		for _, name := range names {
+			roles := []string{}
+			if domain, ok := domains[name].(map[string]interface{}); ok {
+				roles = getResponseNames(domain["roles"])
+			}
+			rolesSet, roleDiags := types.SetValueFrom(ctx, types.StringType, roles)
+			diags.Append(roleDiags...)
+			securityDomains = append(securityDomains, LocalUserSecurityDomainModel{
+				Name:  basetypes.NewStringValue(name),
+				Roles: rolesSet,
+			})
+		}
+		securityDomainsSet, setDiags := types.SetValueFrom(ctx, localUserSecurityDomainType, securityDomains)
+		diags.Append(setDiags...)
+		data.SecurityDomains = securityDomainsSet
+	}
+	return data
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccResourceNdLocalUser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: testConfigResourceNdLocalUserCreate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_local_user.test", "id", "terraform_user"),
+					resource.TestCheckResourceAttr("nd_local_user.test", "login_id", "terraform_user"),
+					resource.TestCheckResourceAttr("nd_local_user.test", "first_name", ""),
+					resource.TestCheckResourceAttr("nd_local_user.test", "last_name", ""),
+					resource.TestCheckResourceAttr("nd_local_user.test", "email", ""),
+					resource.TestCheckResourceAttr("nd_local_user.test", "account_status", "active"),
+					resource.TestCheckNoResourceAttr("nd_local_user.test", "password"),
+					resource.TestCheckResourceAttr("nd_local_user.test", "security_domains.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("nd_local_user.test", "security_domains.*", map[string]string{"name": "all", "roles.#": "1", "roles.0": "observer"}),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_local_user.test",
+				ImportState:       true,
+				ImportStateId:     "terraform_user",
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config: testConfigResourceNdLocalUserUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_local_user.test", "id", "terraform_user"),
+					resource.TestCheckResourceAttr("nd_local_user.test", "login_id", "terraform_user"),
+					resource.TestCheckResourceAttr("nd_local_user.test", "first_name", "Terraform"),
+					resource.TestCheckResourceAttr("nd_local_user.test", "last_name", "User"),
+					resource.TestCheckResourceAttr("nd_local_user.test", "email", "terraform_user@example.com"),
+					resource.TestCheckResourceAttr("nd_local_user.test", "account_status", "inactive"),
+					resource.TestCheckResourceAttr("nd_local_user.test", "password_version", "2"),
+					resource.TestCheckResourceAttr("nd_local_user.test", "security_domains.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("nd_local_user.test", "security_domains.*", map[string]string{"name": "all", "roles.#": "2"}),
+					resource.TestCheckTypeSetElemAttr("nd_local_user.test", "security_domains.*.roles.*", "observer"),
+					resource.TestCheckTypeSetElemAttr("nd_local_user.test", "security_domains.*.roles.*", "fabric-admin"),
+				),
+			},
+			// Remove the optional attributes and verify that the values are cleared
+			{
+				Config: testConfigResourceNdLocalUserCreate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_local_user.test", "first_name", ""),
+					resource.TestCheckResourceAttr("nd_local_user.test", "last_name", ""),
+					resource.TestCheckResourceAttr("nd_local_user.test", "email", ""),
+					resource.TestCheckResourceAttr("nd_local_user.test", "account_status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNdLocalUserImportIdentity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testConfigResourceNdLocalUserUpdate,
+			},
+			{
+				ResourceName:    "nd_local_user.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestLocalUserAttributes(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/aaa/localUsers/user1":
+			fmt.Fprint(w, `{
+				"loginID": "user1",
+				"firstName": "First",
+				"accountStatus": "Inactive",
+				"rbac": {"domains": {"all": {"roles": ["observer", {"name": "fabric-admin"}]}, "domain1": {"roles": ["approver"]}}}
+			}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var diags diag.Diagnostics
+	data := getBaseLocalUserResourceModel(basetypes.NewInt64Value(1))
+	data.Id = basetypes.NewStringValue("user1")
+	getAndSetLocalUserAttributes(context.Background(), &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Id.ValueString() != "user1" || data.LoginId.ValueString() != "user1" {
+		t.Errorf("unexpected id %s and login id %s", data.Id, data.LoginId)
+	}
+	if data.FirstName.ValueString() != "First" || data.LastName.ValueString() != "" || data.Email.ValueString() != "" {
+		t.Errorf("unexpected names %s %s and email %s", data.FirstName, data.LastName, data.Email)
+	}
+	if data.AccountStatus.ValueString() != "inactive" {
+		t.Errorf("expected account status inactive, got %s", data.AccountStatus)
+	}
+	if data.PasswordVersion.ValueInt64() != 1 {
+		t.Errorf("expected the password version to be kept, got %s", data.PasswordVersion)
+	}
+
+	var securityDomains []LocalUserSecurityDomainModel
+	diags.Append(data.SecurityDomains.ElementsAs(context.Background(), &securityDomains, false)...)
+	expected := map[string]int{"all": 2, "domain1": 1}
+	if len(securityDomains) != len(expected) {
+		t.Fatalf("expected %d security domains, got %d", len(expected), len(securityDomains))
+	}
+	for _, securityDomain := range securityDomains {
+		if count := len(securityDomain.Roles.Elements()); count != expected[securityDomain.Name.ValueString()] {
+			t.Errorf("expected %d roles in security domain %s, got %d", expected[securityDomain.Name.ValueString()], securityDomain.Name, count)
+		}
+	}
+
+	data.Id = basetypes.NewStringValue("user2")
+	getAndSetLocalUserAttributes(context.Background(), &diags, client, data)
+	if diags.HasError() || !data.Id.IsNull() {
+		t.Errorf("expected a null id for a deleted user, got %s: %v", data.Id, diags)
+	}
+}
+
+const testConfigResourceNdLocalUserCreate = `
+resource "nd_local_user" "test" {
+  login_id = "terraform_user"
+  password = "C1sco12345!"
+  security_domains = [
+    {
+      name  = "all"
+      roles = ["observer"]
+    }
+  ]
+}
+`
+
+const testConfigResourceNdLocalUserUpdate = `
+resource "nd_local_user" "test" {
+  login_id         = "terraform_user"
+  first_name       = "Terraform"
+  last_name        = "User"
+  email            = "terraform_user@example.com"
+  password         = "C1sco12345!!"
+  password_version = 2
+  account_status   = "inactive"
+  security_domains = [
+    {
+      name  = "all"
+      roles = ["observer", "fabric-admin"]
+    }
+  ]
+}
+`
@@ -0,0 +1,900 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LoginDomainResource{}
+var _ resource.ResourceWithImportState = &LoginDomainResource{}
+var _ resource.ResourceWithValidateConfig = &LoginDomainResource{}
+var _ resource.ResourceWithModifyPlan = &LoginDomainResource{}
+
+// loginDomainRealms are the realms of a login domain, each realm is configured with the attribute of the same name.
+var loginDomainRealms = []string{"ldap", "radius", "tacacs", "saml", "oidc"}
+
+// loginDomainSingleSignOnRealms are the realms which are only supported by the versions of ND supporting nd.FeatureSingleSignOnLoginDomains.
+var loginDomainSingleSignOnRealms = []string{"saml", "oidc"}
+
+var loginDomainLdapType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"hosts":         types.ListType{ElemType: types.StringType},
+		"port":          types.Int64Type,
+		"base_dn":       types.StringType,
+		"bind_dn":       types.StringType,
+		"bind_password": types.StringType,
+		"filter":        types.StringType,
+		"attribute":     types.StringType,
+		"ssl":           types.BoolType,
+		"ssl_verify":    types.BoolType,
+		"timeout":       types.Int64Type,
+	},
+}
+
+var loginDomainServerType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"hostname": types.StringType,
+		"port":     types.Int64Type,
+		"key":      types.StringType,
+	},
+}
+
+// loginDomainServerRealmType is the type of the radius and tacacs attributes.
+var loginDomainServerRealmType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"servers":                 types.ListType{ElemType: loginDomainServerType},
+		"authentication_protocol": types.StringType,
+		"timeout":                 types.Int64Type,
+		"retries":                 types.Int64Type,
+	},
+}
+
+var loginDomainSamlType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"entity_id":        types.StringType,
+		"idp_metadata_url": types.StringType,
+		"role_attribute":   types.StringType,
+	},
+}
+
+var loginDomainOidcType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"issuer_url":    types.StringType,
+		"client_id":     types.StringType,
+		"client_secret": types.StringType,
+		"scopes":        types.ListType{ElemType: types.StringType},
+		"role_claim":    types.StringType,
+	},
+}
+
+func NewLoginDomainResource() resource.Resource {
+	return &LoginDomainResource{}
+}
+
+// LoginDomainResource defines the resource implementation.
+type LoginDomainResource struct {
+	client *client.Client
+}
+
+// LoginDomainResourceModel describes the resource data model.
+type LoginDomainResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Realm          types.String `tfsdk:"realm"`
+	SecretsVersion types.Int64  `tfsdk:"secrets_version"`
+	Ldap           types.Object `tfsdk:"ldap"`
+	Radius         types.Object `tfsdk:"radius"`
+	Tacacs         types.Object `tfsdk:"tacacs"`
+	Saml           types.Object `tfsdk:"saml"`
+	Oidc           types.Object `tfsdk:"oidc"`
+}
+
+// LoginDomainLdapModel describes the LDAP configuration of a login domain.
+type LoginDomainLdapModel struct {
+	Hosts        types.List   `tfsdk:"hosts"`
+	Port         types.Int64  `tfsdk:"port"`
+	BaseDn       types.String `tfsdk:"base_dn"`
+	BindDn       types.String `tfsdk:"bind_dn"`
+	BindPassword types.String `tfsdk:"bind_password"`
+	Filter       types.String `tfsdk:"filter"`
+	Attribute    types.String `tfsdk:"attribute"`
+	Ssl          types.Bool   `tfsdk:"ssl"`
+	SslVerify    types.Bool   `tfsdk:"ssl_verify"`
+	Timeout      types.Int64  `tfsdk:"timeout"`
+}
+
+// LoginDomainServerRealmModel describes the RADIUS or TACACS+ configuration of a login domain.
+type LoginDomainServerRealmModel struct {
+	Servers                types.List   `tfsdk:"servers"`
+	AuthenticationProtocol types.String `tfsdk:"authentication_protocol"`
+	Timeout                types.Int64  `tfsdk:"timeout"`
+	Retries                types.Int64  `tfsdk:"retries"`
+}
+
+// LoginDomainServerModel describes a RADIUS or TACACS+ server of a login domain.
+type LoginDomainServerModel struct {
+	Hostname types.String `tfsdk:"hostname"`
+	Port     types.Int64  `tfsdk:"port"`
+	Key      types.String `tfsdk:"key"`
+}
+
+// LoginDomainSamlModel describes the SAML configuration of a login domain.
+type LoginDomainSamlModel struct {
+	EntityId       types.String `tfsdk:"entity_id"`
+	IdpMetadataUrl types.String `tfsdk:"idp_metadata_url"`
+	RoleAttribute  types.String `tfsdk:"role_attribute"`
+}
+
+// LoginDomainOidcModel describes the OIDC configuration of a login domain.
+type LoginDomainOidcModel struct {
+	IssuerUrl    types.String `tfsdk:"issuer_url"`
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	RoleClaim    types.String `tfsdk:"role_claim"`
+}
+
+func getBaseLoginDomainResourceModel(secretsVersion basetypes.Int64Value) *LoginDomainResourceModel {
+	return &LoginDomainResourceModel{
+		Id:             basetypes.NewStringNull(),
+		Name:           basetypes.NewStringNull(),
+		Description:    basetypes.NewStringNull(),
+		Realm:          basetypes.NewStringNull(),
+		SecretsVersion: secretsVersion,
+		Ldap:           basetypes.NewObjectNull(loginDomainLdapType.AttrTypes),
+		Radius:         basetypes.NewObjectNull(loginDomainServerRealmType.AttrTypes),
+		Tacacs:         basetypes.NewObjectNull(loginDomainServerRealmType.AttrTypes),
+		Saml:           basetypes.NewObjectNull(loginDomainSamlType.AttrTypes),
+		Oidc:           basetypes.NewObjectNull(loginDomainOidcType.AttrTypes),
+	}
+}
+
+func (r *LoginDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_login_domain")
+	resp.TypeName = req.ProviderTypeName + "_login_domain"
+	tflog.Debug(ctx, "End metadata of resource: nd_login_domain")
+}
+
+// getLoginDomainServerRealmAttribute returns the schema of the radius and tacacs attributes, which only differ in
+// the default port and the authentication protocols of the servers.
+func getLoginDomainServerRealmAttribute(realmName string, defaultPort int64, protocols []string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: fmt.Sprintf("The %s configuration of the login domain, required when the `realm` is '%s'.", realmName, realmName),
+		Attributes: map[string]schema.Attribute{
+			"servers": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The %s servers in order of priority.", realmName),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hostname": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The hostname or IP address of the server.",
+						},
+						"port": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(defaultPort),
+							MarkdownDescription: "The port of the server.",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"key": schema.StringAttribute{
+							Required:            true,
+							Sensitive:           true,
+							WriteOnly:           true,
+							MarkdownDescription: "The shared secret of the server. The key is write-only and never stored in the state, it is sent to ND when the login domain is created and when the `secrets_version`, the `realm` or the servers change.",
+						},
+					},
+				},
+			},
+			"authentication_protocol": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(protocols[0]),
+				MarkdownDescription: "The authentication protocol used with the servers.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(protocols...),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5),
+				MarkdownDescription: "The timeout in seconds of the requests to a server.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60),
+				},
+			},
+			"retries": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "The number of retries of a request before the next server is used.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 5),
+				},
+			},
+		},
+	}
+}
+
+func (r *LoginDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_login_domain")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Login Domains for Nexus Dashboard",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the login domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the login domain, the name is used as `login_domain` of the provider to authenticate with the domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The description of the login domain.",
+			},
+			"realm": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The realm of the login domain, the realm is configured with the attribute of the same name. The 'saml' and 'oidc' realms require ND 4.1 or later.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(loginDomainRealms...),
+				},
+			},
+			"secrets_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of the secrets of the login domain, changing the version updates the write-only secrets of the login domain in ND.",
+			},
+			"ldap": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The LDAP configuration of the login domain, required when the `realm` is 'ldap'.",
+				Attributes: map[string]schema.Attribute{
+					"hosts": schema.ListAttribute{
+						Required:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "The hostnames or IP addresses of the LDAP servers in order of priority.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"port": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(389),
+						MarkdownDescription: "The port of the LDAP servers.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 65535),
+						},
+					},
+					"base_dn": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The DN of the LDAP subtree in which the users are searched, ie 'ou=users,dc=example,dc=com'.",
+					},
+					"bind_dn": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+						MarkdownDescription: "The DN of the account used to search the users, the users are searched anonymously when the DN is empty.",
+					},
+					"bind_password": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						MarkdownDescription: "The password of the `bind_dn` account. The password is write-only and never stored in the state, it is sent to ND when the login domain is created and when the `secrets_version` or the `realm` changes.",
+					},
+					"filter": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("cn=$userid"),
+						MarkdownDescription: "The filter used to search a user, `$userid` is replaced by the login ID of the user.",
+					},
+					"attribute": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("CiscoAVPair"),
+						MarkdownDescription: "The LDAP attribute mapping the user to its security domains and roles.",
+					},
+					"ssl": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Use SSL to connect to the LDAP servers.",
+					},
+					"ssl_verify": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Verify the certificate of the LDAP servers, only applicable when `ssl` is enabled.",
+					},
+					"timeout": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(30),
+						MarkdownDescription: "The timeout in seconds of the requests to an LDAP server.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 60),
+						},
+					},
+				},
+			},
+			"radius": getLoginDomainServerRealmAttribute("radius", 1812, []string{"pap", "chap", "mschap"}),
+			"tacacs": getLoginDomainServerRealmAttribute("tacacs", 49, []string{"pap", "chap", "ascii"}),
+			"saml": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The SAML configuration of the login domain, required when the `realm` is 'saml'.",
+				Attributes: map[string]schema.Attribute{
+					"entity_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The entity ID of ND at the identity provider.",
+					},
+					"idp_metadata_url": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The URL of the metadata of the identity provider.",
+					},
+					"role_attribute": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("CiscoAVPair"),
+						MarkdownDescription: "The SAML attribute mapping the user to its security domains and roles.",
+					},
+				},
+			},
+			"oidc": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The OIDC configuration of the login domain, required when the `realm` is 'oidc'.",
+				Attributes: map[string]schema.Attribute{
+					"issuer_url": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The URL of the OIDC issuer.",
+					},
+					"client_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The ID of ND at the OIDC issuer.",
+					},
+					"client_secret": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						MarkdownDescription: "The secret of ND at the OIDC issuer. The secret is write-only and never stored in the state, it is sent to ND when the login domain is created and when the `secrets_version` or the `realm` changes.",
+					},
+					"scopes": schema.ListAttribute{
+						Optional:            true,
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "The scopes requested from the OIDC issuer, ND requests the 'openid', 'profile' and 'email' scopes when none are configured.",
+					},
+					"role_claim": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("CiscoAVPair"),
+						MarkdownDescription: "The claim mapping the user to its security domains and roles.",
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_login_domain")
+}
+
+func (r *LoginDomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData *LoginDomainResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() || configData.Realm.IsUnknown() || configData.Realm.IsNull() {
+		return
+	}
+
+	realm := configData.Realm.ValueString()
+	realmConfigs := map[string]types.Object{
+		"ldap":   configData.Ldap,
+		"radius": configData.Radius,
+		"tacacs": configData.Tacacs,
+		"saml":   configData.Saml,
+		"oidc":   configData.Oidc,
+	}
+	for _, name := range loginDomainRealms {
+		if name == realm && realmConfigs[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				fmt.Sprintf("Missing %s configuration", name),
+				fmt.Sprintf("The '%s' attribute is required when the 'realm' is '%s'.", name, realm),
+			)
+		} else if name != realm && !realmConfigs[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				fmt.Sprintf("Invalid %s configuration", name),
+				fmt.Sprintf("The '%s' attribute is invalid when the 'realm' is '%s'.", name, realm),
+			)
+		}
+	}
+
+	if realm == "ldap" && !configData.Ldap.IsNull() && !configData.Ldap.IsUnknown() {
+		var ldap LoginDomainLdapModel
+		resp.Diagnostics.Append(configData.Ldap.As(ctx, &ldap, basetypes.ObjectAsOptions{})...)
+		if !ldap.BindDn.IsNull() && !ldap.BindDn.IsUnknown() && ldap.BindDn.ValueString() != "" && ldap.BindPassword.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ldap").AtName("bind_password"),
+				"Missing bind_password",
+				"The 'bind_password' attribute is required when the 'bind_dn' is configured.",
+			)
+		}
+		if !ldap.SslVerify.IsNull() && !ldap.SslVerify.IsUnknown() && ldap.SslVerify.ValueBool() && !ldap.Ssl.IsUnknown() && !ldap.Ssl.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ldap").AtName("ssl_verify"),
+				"Invalid ssl_verify",
+				"The 'ssl_verify' attribute can only be enabled when 'ssl' is enabled.",
+			)
+		}
+	}
+}
+
+func (r *LoginDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var planData *LoginDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() || planData.Realm.IsUnknown() {
+		return
+	}
+
+	// The SAML and OIDC realms are rejected during the plan when the version of ND does not support them.
+	for _, realm := range loginDomainSingleSignOnRealms {
+		if planData.Realm.ValueString() == realm {
+			r.client.GetCapability(ctx, &resp.Diagnostics, nd.FeatureSingleSignOnLoginDomains)
+		}
+	}
+}
+
+func (r *LoginDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_login_domain")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_login_domain")
+}
+
+func (r *LoginDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_login_domain")
+
+	var planData *LoginDomainResourceModel
+	var configData *LoginDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getLoginDomainJsonPayload(ctx, &resp.Diagnostics, planData, configData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureLoginDomains)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "POST", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = types.StringValue(planData.Name.ValueString())
+	getAndSetLoginDomainAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_login_domain with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *LoginDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_login_domain")
+	var stateData *LoginDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_login_domain with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetLoginDomainAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *LoginDomainResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_login_domain with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *LoginDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_login_domain")
+
+	var planData *LoginDomainResourceModel
+	var stateData *LoginDomainResourceModel
+	var configData *LoginDomainResourceModel
+
+	// Read Terraform plan data and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_login_domain with id '%s'", planData.Id.ValueString()))
+
+	// The secrets are only sent when the secrets version changes, ND keeps the current secrets when they are not provided.
+	// The secrets are also sent when the realm or the RADIUS and TACACS+ servers change, because ND cannot match the
+	// current keys to a new list of servers.
+	secretsData := configData
+	if planData.SecretsVersion.Equal(stateData.SecretsVersion) && planData.Realm.Equal(stateData.Realm) && !loginDomainServersChanged(planData, stateData) {
+		secretsData = nil
+	}
+
+	jsonPayload := getLoginDomainJsonPayload(ctx, &resp.Diagnostics, planData, secretsData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureLoginDomains)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, planData.Id.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetLoginDomainAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_login_domain")
+}
+
+func (r *LoginDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_login_domain")
+	var stateData *LoginDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_login_domain with id '%s'", stateData.Id.ValueString()))
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureLoginDomains)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, stateData.Id.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_login_domain with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *LoginDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_login_domain")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_login_domain with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_login_domain")
+}
+
+// loginDomainServersChanged returns true when the RADIUS or TACACS+ servers of the plan differ from the servers of the state.
+func loginDomainServersChanged(planData, stateData *LoginDomainResourceModel) bool {
+	for _, realms := range [][2]types.Object{{planData.Radius, stateData.Radius}, {planData.Tacacs, stateData.Tacacs}} {
+		planServers, stateServers := getLoginDomainServers(realms[0]), getLoginDomainServers(realms[1])
+		if planServers == nil || stateServers == nil {
+			if planServers != stateServers {
+				return true
+			}
+		} else if !planServers.Equal(stateServers) {
+			return true
+		}
+	}
+	return false
+}
+
+// getLoginDomainServers returns the servers of a RADIUS or TACACS+ configuration, nil is returned when the configuration
+// is not set.
+func getLoginDomainServers(realm types.Object) attr.Value {
+	if realm.IsNull() || realm.IsUnknown() {
+		return nil
+	}
+	return realm.Attributes()["servers"]
+}
+
+// getLoginDomainJsonPayload returns the payload of the login domain, the write-only secrets are read from the
+// configuration and are omitted when the configuration is nil.
+func getLoginDomainJsonPayload(ctx context.Context, diags *diag.Diagnostics, data, configData *LoginDomainResourceModel) *gabs.Container {
+	payload := gabs.New()
+
+	setPayloadValue(diags, payload, data.Name.ValueString(), "/name")
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		setPayloadValue(diags, payload, data.Description.ValueString(), "/description")
+	}
+	realm := data.Realm.ValueString()
+	setPayloadValue(diags, payload, realm, "/realm")
+
+	switch realm {
+	case "ldap":
+		var ldap, ldapConfig LoginDomainLdapModel
+		diags.Append(data.Ldap.As(ctx, &ldap, basetypes.ObjectAsOptions{})...)
+		hosts := make([]string, 0)
+		diags.Append(ldap.Hosts.ElementsAs(ctx, &hosts, false)...)
+		setPayloadValue(diags, payload, hosts, "/ldap/hosts")
+		setPayloadValue(diags, payload, ldap.Port.ValueInt64(), "/ldap/port")
+		setPayloadValue(diags, payload, ldap.BaseDn.ValueString(), "/ldap/baseDN")
+		setPayloadValue(diags, payload, ldap.BindDn.ValueString(), "/ldap/bindDN")
+		setPayloadValue(diags, payload, ldap.Filter.ValueString(), "/ldap/filter")
+		setPayloadValue(diags, payload, ldap.Attribute.ValueString(), "/ldap/attribute")
+		setPayloadValue(diags, payload, ldap.Ssl.ValueBool(), "/ldap/sslEnabled")
+		setPayloadValue(diags, payload, ldap.SslVerify.ValueBool(), "/ldap/sslVerify")
+		setPayloadValue(diags, payload, ldap.Timeout.ValueInt64(), "/ldap/timeout")
+		if configData != nil {
+			diags.Append(configData.Ldap.As(ctx, &ldapConfig, basetypes.ObjectAsOptions{})...)
+			if !ldapConfig.BindPassword.IsNull() && !ldapConfig.BindPassword.IsUnknown() {
+				setPayloadValue(diags, payload, ldapConfig.BindPassword.ValueString(), "/ldap/bindPassword")
+			}
+		}
+	case "radius", "tacacs":
+		realmData, realmConfig := data.Radius, basetypes.NewObjectNull(loginDomainServerRealmType.AttrTypes)
+		if realm == "tacacs" {
+			realmData = data.Tacacs
+		}
+		if configData != nil {
+			realmConfig = configData.Radius
+			if realm == "tacacs" {
+				realmConfig = configData.Tacacs
+			}
+		}
+		setLoginDomainServerRealmPayload(ctx, diags, payload, realm, realmData, realmConfig)
+	case "saml":
+		var saml LoginDomainSamlModel
+		diags.Append(data.Saml.As(ctx, &saml, basetypes.ObjectAsOptions{})...)
+		setPayloadValue(diags, payload, saml.EntityId.ValueString(), "/saml/entityID")
+		setPayloadValue(diags, payload, saml.IdpMetadataUrl.ValueString(), "/saml/idpMetadataURL")
+		setPayloadValue(diags, payload, saml.RoleAttribute.ValueString(), "/saml/roleAttribute")
+	case "oidc":
+		var oidc, oidcConfig LoginDomainOidcModel
+		diags.Append(data.Oidc.As(ctx, &oidc, basetypes.ObjectAsOptions{})...)
+		setPayloadValue(diags, payload, oidc.IssuerUrl.ValueString(), "/oidc/issuerURL")
+		setPayloadValue(diags, payload, oidc.ClientId.ValueString(), "/oidc/clientID")
+		setPayloadValue(diags, payload, oidc.RoleClaim.ValueString(), "/oidc/roleClaim")
+		if !oidc.Scopes.IsNull() && !oidc.Scopes.IsUnknown() {
+			scopes := make([]string, 0)
+			diags.Append(oidc.Scopes.ElementsAs(ctx, &scopes, false)...)
+			setPayloadValue(diags, payload, scopes, "/oidc/scopes")
+		}
+		if configData != nil {
+			diags.Append(configData.Oidc.As(ctx, &oidcConfig, basetypes.ObjectAsOptions{})...)
+			if !oidcConfig.ClientSecret.IsNull() && !oidcConfig.ClientSecret.IsUnknown() {
+				setPayloadValue(diags, payload, oidcConfig.ClientSecret.ValueString(), "/oidc/clientSecret")
+			}
+		}
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+// setLoginDomainServerRealmPayload sets the RADIUS or TACACS+ configuration in the payload, the keys of the servers are
+// read from the configuration of the server with the same hostname.
+func setLoginDomainServerRealmPayload(ctx context.Context, diags *diag.Diagnostics, payload *gabs.Container, realm string, realmData, realmConfig types.Object) {
+	var serverRealm, serverRealmConfig LoginDomainServerRealmModel
+	diags.Append(realmData.As(ctx, &serverRealm, basetypes.ObjectAsOptions{})...)
+
+	var servers, serversConfig []LoginDomainServerModel
+	diags.Append(serverRealm.Servers.ElementsAs(ctx, &servers, false)...)
+	if !realmConfig.IsNull() && !realmConfig.IsUnknown() {
+		diags.Append(realmConfig.As(ctx, &serverRealmConfig, basetypes.ObjectAsOptions{})...)
+		diags.Append(serverRealmConfig.Servers.ElementsAs(ctx, &serversConfig, false)...)
+	}
+
+	keys := make(map[string]string, len(serversConfig))
+	for _, serverConfig := range serversConfig {
+		if !serverConfig.Key.IsNull() && !serverConfig.Key.IsUnknown() {
+			keys[serverConfig.Hostname.ValueString()] = serverConfig.Key.ValueString()
+		}
+	}
+
+	serversPayload := make([]map[string]interface{}, 0, len(servers))
+	for _, server := range servers {
+		serverPayload := map[string]interface{}{
+			"host": server.Hostname.ValueString(),
+			"port": server.Port.ValueInt64(),
+		}
+		if key, ok := keys[server.Hostname.ValueString()]; ok {
+			serverPayload["key"] = key
+		}
+		serversPayload = append(serversPayload, serverPayload)
+	}
+	setPayloadValue(diags, payload, serversPayload, fmt.Sprintf("/%s/servers", realm))
+	setPayloadValue(diags, payload, serverRealm.AuthenticationProtocol.ValueString(), fmt.Sprintf("/%s/authProtocol", realm))
+	setPayloadValue(diags, payload, serverRealm.Timeout.ValueInt64(), fmt.Sprintf("/%s/timeout", realm))
+	setPayloadValue(diags, payload, serverRealm.Retries.ValueInt64(), fmt.Sprintf("/%s/retries", realm))
+}
+
+func getAndSetLoginDomainAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *LoginDomainResourceModel) {
+	apiPath := client.GetPath(ctx, diags, nd.FeatureLoginDomains)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "GET", nil)
+	// The API does not return the secrets, the secrets_version is assigned based on the user's configuration settings.
+	*data = *getBaseLoginDomainResourceModel(data.SecretsVersion)
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		data.Id = basetypes.NewStringNull()
+		return
+	}
+
+	if value, ok := getResponseValue(responseData, "/name").(string); ok {
+		data.Id = basetypes.NewStringValue(value)
+		data.Name = basetypes.NewStringValue(value)
+	}
+	data.Description = basetypes.NewStringValue("")
+	if value, ok := getResponseValue(responseData, "/description").(string); ok {
+		data.Description = basetypes.NewStringValue(value)
+	}
+	realm, _ := getResponseValue(responseData, "/realm").(string)
+	data.Realm = basetypes.NewStringValue(realm)
+
+	switch realm {
+	case "ldap":
+		ldap := LoginDomainLdapModel{
+			Port:         basetypes.NewInt64Value(getResponseInt64(responseData, "/ldap/port")),
+			BaseDn:       basetypes.NewStringValue(getResponseString(responseData, "/ldap/baseDN")),
+			BindDn:       basetypes.NewStringValue(getResponseString(responseData, "/ldap/bindDN")),
+			BindPassword: basetypes.NewStringNull(),
+			Filter:       basetypes.NewStringValue(getResponseString(responseData, "/ldap/filter")),
+			Attribute:    basetypes.NewStringValue(getResponseString(responseData, "/ldap/attribute")),
+			Ssl:          basetypes.NewBoolValue(getResponseValue(responseData, "/ldap/sslEnabled") == true),
+			SslVerify:    basetypes.NewBoolValue(getResponseValue(responseData, "/ldap/sslVerify") == true),
+			Timeout:      basetypes.NewInt64Value(getResponseInt64(responseData, "/ldap/timeout")),
+		}
+		hosts, listDiags := types.ListValueFrom(ctx, types.StringType, getResponseNames(getResponseValue(responseData, "/ldap/hosts")))
+		diags.Append(listDiags...)
+		ldap.Hosts = hosts
+		ldapObject, objectDiags := types.ObjectValueFrom(ctx, loginDomainLdapType.AttrTypes, ldap)
+		diags.Append(objectDiags...)
+		data.Ldap = ldapObject
+	case "radius", "tacacs":
+		serverRealm := LoginDomainServerRealmModel{
+			AuthenticationProtocol: basetypes.NewStringValue(getResponseString(responseData, fmt.Sprintf("/%s/authProtocol", realm))),
+			Timeout:                basetypes.NewInt64Value(getResponseInt64(responseData, fmt.Sprintf("/%s/timeout", realm))),
+			Retries:                basetypes.NewInt64Value(getResponseInt64(responseData, fmt.Sprintf("/%s/retries", realm))),
+		}
+		servers := []LoginDomainServerModel{}
+		if serversData, err := responseData.JSONPointer(fmt.Sprintf("/%s/servers", realm)); err == nil {
+			for _, serverData := range serversData.Children() {
+				servers = append(servers, LoginDomainServerModel{
+					Hostname: basetypes.NewStringValue(getResponseString(serverData, "/host")),
+					Port:     basetypes.NewInt64Value(getResponseInt64(serverData, "/port")),
+					Key:      basetypes.NewStringNull(),
+				})
+			}
+		}
+		serversList, listDiags := types.ListValueFrom(ctx, loginDomainServerType, servers)
+		diags.Append(listDiags...)
+		serverRealm.Servers = serversList
+		serverRealmObject, objectDiags := types.ObjectValueFrom(ctx, loginDomainServerRealmType.AttrTypes, serverRealm)
+		diags.Append(objectDiags...)
+		if realm == "radius" {
+			data.Radius = serverRealmObject
+		} else {
+			data.Tacacs = serverRealmObject
+		}
+	case "saml":
+		saml := LoginDomainSamlModel{
+			EntityId:       basetypes.NewStringValue(getResponseString(responseData, "/saml/entityID")),
+			IdpMetadataUrl: basetypes.NewStringValue(getResponseString(responseData, "/saml/idpMetadataURL")),
+			RoleAttribute:  basetypes.NewStringValue(getResponseString(responseData, "/saml/roleAttribute")),
+		}
+		samlObject, objectDiags := types.ObjectValueFrom(ctx, loginDomainSamlType.AttrTypes, saml)
+		diags.Append(objectDiags...)
+		data.Saml = samlObject
+	case "oidc":
+		oidc := LoginDomainOidcModel{
+			IssuerUrl:    basetypes.NewStringValue(getResponseString(responseData, "/oidc/issuerURL")),
+			ClientId:     basetypes.NewStringValue(getResponseString(responseData, "/oidc/clientID")),
+			ClientSecret: basetypes.NewStringNull(),
+			RoleClaim:    basetypes.NewStringValue(getResponseString(responseData, "/oidc/roleClaim")),
+		}
+		scopes := getResponseNames(getResponseValue(responseData, "/oidc/scopes"))
+		scopesList, listDiags := types.ListValueFrom(ctx, types.StringType, scopes)
+		diags.Append(listDiags...)
+		oidc.Scopes = scopesList
+		oidcObject, objectDiags := types.ObjectValueFrom(ctx, loginDomainOidcType.AttrTypes, oidc)
+		diags.Append(objectDiags...)
+		data.Oidc = oidcObject
+	}
+}
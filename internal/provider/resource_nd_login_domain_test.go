@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceNdLoginDomainLdap(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdLoginDomainLdapCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_login_domain.test", "id", "terraform_ldap"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "name", "terraform_ldap"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "description", ""),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "realm", "ldap"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.hosts.#", "1"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.hosts.0", "10.0.0.1"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.port", "389"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.base_dn", "ou=users,dc=example,dc=com"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.bind_dn", ""),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.filter", "cn=$userid"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.attribute", "CiscoAVPair"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.ssl", "false"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.ssl_verify", "false"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.timeout", "30"),
+					resource.TestCheckNoResourceAttr("nd_login_domain.test", "ldap.bind_password"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_login_domain.test",
+				ImportState:       true,
+				ImportStateId:     "terraform_ldap",
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdLoginDomainLdapUpdate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_login_domain.test", "id", "terraform_ldap"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "description", "Created by Terraform"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "secrets_version", "1"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.hosts.#", "2"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.hosts.0", "10.0.0.1"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.hosts.1", "10.0.0.2"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.port", "636"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.bind_dn", "cn=admin,dc=example,dc=com"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.ssl", "true"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.ssl_verify", "true"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "ldap.timeout", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNdLoginDomainRadius(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdLoginDomainRadius,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_login_domain.test", "id", "terraform_radius"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "realm", "radius"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "radius.servers.#", "1"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "radius.servers.0.hostname", "10.0.0.3"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "radius.servers.0.port", "1812"),
+					resource.TestCheckNoResourceAttr("nd_login_domain.test", "radius.servers.0.key"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "radius.authentication_protocol", "pap"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "radius.timeout", "5"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "radius.retries", "1"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:            "nd_login_domain.test",
+				ImportState:             true,
+				ImportStateId:           "terraform_radius",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secrets_version"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNdLoginDomainTacacs(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdLoginDomainTacacs,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_login_domain.test", "id", "terraform_tacacs"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "realm", "tacacs"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "tacacs.servers.#", "2"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "tacacs.servers.0.hostname", "10.0.0.4"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "tacacs.servers.0.port", "49"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "tacacs.servers.1.hostname", "10.0.0.5"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "tacacs.servers.1.port", "4949"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "tacacs.authentication_protocol", "chap"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "tacacs.timeout", "10"),
+					resource.TestCheckResourceAttr("nd_login_domain.test", "tacacs.retries", "2"),
+				),
+			},
+		},
+	})
+}
+
+// Validate Realm Errors
+func TestAccResourceNdLoginDomainError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfigResourceNdLoginDomainMissingRealmError,
+				ExpectError: regexp.MustCompile("The 'radius' attribute is required when the 'realm' is 'radius'"),
+			},
+			{
+				Config:      testConfigResourceNdLoginDomainInvalidRealmError,
+				ExpectError: regexp.MustCompile("The 'ldap' attribute is invalid when the 'realm' is 'tacacs'"),
+			},
+			{
+				Config:      testConfigResourceNdLoginDomainBindPasswordError,
+				ExpectError: regexp.MustCompile("The 'bind_password' attribute is required when the 'bind_dn' is configured"),
+			},
+			{
+				Config:      testConfigResourceNdLoginDomainSslVerifyError,
+				ExpectError: regexp.MustCompile("The 'ssl_verify' attribute can only be enabled when 'ssl' is enabled"),
+			},
+		},
+	})
+}
+
+func TestLoginDomainAttributes(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/aaa/loginDomains/radius1":
+			fmt.Fprint(w, `{
+				"name": "radius1",
+				"realm": "radius",
+				"radius": {"servers": [{"host": "10.0.0.1", "port": 1812}, {"host": "10.0.0.2", "port": 1645}], "authProtocol": "chap", "timeout": 10, "retries": 2}
+			}`)
+		case "/api/v1/infra/aaa/loginDomains/ldap1":
+			fmt.Fprint(w, `{
+				"name": "ldap1",
+				"description": "LDAP",
+				"realm": "ldap",
+				"ldap": {"hosts": ["10.0.0.3"], "port": 636, "baseDN": "dc=example,dc=com", "bindDN": "", "filter": "uid=$userid", "attribute": "memberOf", "sslEnabled": true, "sslVerify": false, "timeout": 30}
+			}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var diags diag.Diagnostics
+	data := getBaseLoginDomainResourceModel(basetypes.NewInt64Value(1))
+	data.Id = basetypes.NewStringValue("radius1")
+	getAndSetLoginDomainAttributes(context.Background(), &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Name.ValueString() != "radius1" || data.Realm.ValueString() != "radius" || data.Description.ValueString() != "" {
+		t.Errorf("unexpected name %s, realm %s and description %s", data.Name, data.Realm, data.Description)
+	}
+	if data.SecretsVersion.ValueInt64() != 1 {
+		t.Errorf("expected the secrets version to be kept, got %s", data.SecretsVersion)
+	}
+	if !data.Ldap.IsNull() || !data.Tacacs.IsNull() || data.Radius.IsNull() {
+		t.Fatalf("expected only the radius configuration, got ldap %s, radius %s and tacacs %s", data.Ldap, data.Radius, data.Tacacs)
+	}
+	var radius LoginDomainServerRealmModel
+	var servers []LoginDomainServerModel
+	diags.Append(data.Radius.As(context.Background(), &radius, basetypes.ObjectAsOptions{})...)
+	diags.Append(radius.Servers.ElementsAs(context.Background(), &servers, false)...)
+	if len(servers) != 2 || servers[1].Hostname.ValueString() != "10.0.0.2" || servers[1].Port.ValueInt64() != 1645 || !servers[1].Key.IsNull() {
+		t.Errorf("unexpected servers %v", servers)
+	}
+	if radius.AuthenticationProtocol.ValueString() != "chap" || radius.Timeout.ValueInt64() != 10 || radius.Retries.ValueInt64() != 2 {
+		t.Errorf("unexpected radius configuration %v", radius)
+	}
+
+	data.Id = basetypes.NewStringValue("ldap1")
+	getAndSetLoginDomainAttributes(context.Background(), &diags, client, data)
+	var ldap LoginDomainLdapModel
+	diags.Append(data.Ldap.As(context.Background(), &ldap, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(ldap.Hosts.Elements()) != 1 || ldap.Port.ValueInt64() != 636 || ldap.Filter.ValueString() != "uid=$userid" || !ldap.Ssl.ValueBool() || ldap.SslVerify.ValueBool() || !ldap.BindPassword.IsNull() {
+		t.Errorf("unexpected ldap configuration %v", ldap)
+	}
+
+	data.Id = basetypes.NewStringValue("tacacs1")
+	getAndSetLoginDomainAttributes(context.Background(), &diags, client, data)
+	if diags.HasError() || !data.Id.IsNull() {
+		t.Errorf("expected a null id for a deleted login domain, got %s: %v", data.Id, diags)
+	}
+}
+
+func TestLoginDomainServerKeys(t *testing.T) {
+	ctx := context.Background()
+	radius := func(key bool, hostnames ...string) types.Object {
+		servers := make([]LoginDomainServerModel, 0, len(hostnames))
+		for _, hostname := range hostnames {
+			server := LoginDomainServerModel{Hostname: basetypes.NewStringValue(hostname), Port: basetypes.NewInt64Value(1812), Key: basetypes.NewStringNull()}
+			if key {
+				server.Key = basetypes.NewStringValue("key-" + hostname)
+			}
+			servers = append(servers, server)
+		}
+		serversList, diags := types.ListValueFrom(ctx, loginDomainServerType, servers)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		realm, diags := types.ObjectValueFrom(ctx, loginDomainServerRealmType.AttrTypes, LoginDomainServerRealmModel{
+			Servers:                serversList,
+			AuthenticationProtocol: basetypes.NewStringValue("pap"),
+			Timeout:                basetypes.NewInt64Value(5),
+			Retries:                basetypes.NewInt64Value(1),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return realm
+	}
+	loginDomain := func(realm types.Object) *LoginDomainResourceModel {
+		data := getBaseLoginDomainResourceModel(basetypes.NewInt64Value(1))
+		data.Name = basetypes.NewStringValue("radius1")
+		data.Realm = basetypes.NewStringValue("radius")
+		data.Radius = realm
+		return data
+	}
+
+	stateData := loginDomain(radius(false, "10.0.0.1"))
+	if loginDomainServersChanged(loginDomain(radius(false, "10.0.0.1")), stateData) {
+		t.Errorf("expected the servers to be unchanged")
+	}
+	if !loginDomainServersChanged(loginDomain(radius(false, "10.0.0.2", "10.0.0.1")), stateData) {
+		t.Errorf("expected the servers to be changed when a server is added")
+	}
+
+	var diags diag.Diagnostics
+	planData := loginDomain(radius(false, "10.0.0.2", "10.0.0.1"))
+	configData := loginDomain(radius(true, "10.0.0.2", "10.0.0.1"))
+	payload := getLoginDomainJsonPayload(ctx, &diags, planData, configData)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	expected := `"servers":[{"host":"10.0.0.2","key":"key-10.0.0.2","port":1812},{"host":"10.0.0.1","key":"key-10.0.0.1","port":1812}]`
+	if !strings.Contains(payload.String(), expected) {
+		t.Errorf("expected the keys to be matched by hostname, got %s", payload.String())
+	}
+}
+
+const testConfigResourceNdLoginDomainLdapCreate = `
+resource "nd_login_domain" "test" {
+  name  = "terraform_ldap"
+  realm = "ldap"
+  ldap = {
+    hosts   = ["10.0.0.1"]
+    base_dn = "ou=users,dc=example,dc=com"
+  }
+}
+`
+
+const testConfigResourceNdLoginDomainLdapUpdate = `
+resource "nd_login_domain" "test" {
+  name            = "terraform_ldap"
+  description     = "Created by Terraform"
+  realm           = "ldap"
+  secrets_version = 1
+  ldap = {
+    hosts         = ["10.0.0.1", "10.0.0.2"]
+    port          = 636
+    base_dn       = "ou=users,dc=example,dc=com"
+    bind_dn       = "cn=admin,dc=example,dc=com"
+    bind_password = "C1sco12345!"
+    ssl           = true
+    ssl_verify    = true
+    timeout       = 10
+  }
+}
+`
+
+const testConfigResourceNdLoginDomainRadius = `
+resource "nd_login_domain" "test" {
+  name            = "terraform_radius"
+  realm           = "radius"
+  secrets_version = 1
+  radius = {
+    servers = [
+      {
+        hostname = "10.0.0.3"
+        key      = "C1sco12345!"
+      }
+    ]
+  }
+}
+`
+
+const testConfigResourceNdLoginDomainTacacs = `
+resource "nd_login_domain" "test" {
+  name  = "terraform_tacacs"
+  realm = "tacacs"
+  tacacs = {
+    servers = [
+      {
+        hostname = "10.0.0.4"
+        key      = "C1sco12345!"
+      },
+      {
+        hostname = "10.0.0.5"
+        port     = 4949
+        key      = "C1sco12345!"
+      }
+    ]
+    authentication_protocol = "chap"
+    timeout                 = 10
+    retries                 = 2
+  }
+}
+`
+
+const testConfigResourceNdLoginDomainMissingRealmError = `
+resource "nd_login_domain" "test" {
+  name  = "terraform_error"
+  realm = "radius"
+}
+`
+
+const testConfigResourceNdLoginDomainInvalidRealmError = `
+resource "nd_login_domain" "test" {
+  name  = "terraform_error"
+  realm = "tacacs"
+  ldap = {
+    hosts   = ["10.0.0.1"]
+    base_dn = "ou=users,dc=example,dc=com"
+  }
+  tacacs = {
+    servers = [
+      {
+        hostname = "10.0.0.4"
+        key      = "C1sco12345!"
+      }
+    ]
+  }
+}
+`
+
+const testConfigResourceNdLoginDomainBindPasswordError = `
+resource "nd_login_domain" "test" {
+  name  = "terraform_error"
+  realm = "ldap"
+  ldap = {
+    hosts   = ["10.0.0.1"]
+    base_dn = "ou=users,dc=example,dc=com"
+    bind_dn = "cn=admin,dc=example,dc=com"
+  }
+}
+`
+
+const testConfigResourceNdLoginDomainSslVerifyError = `
+resource "nd_login_domain" "test" {
+  name  = "terraform_error"
+  realm = "ldap"
+  ldap = {
+    hosts      = ["10.0.0.1"]
+    base_dn    = "ou=users,dc=example,dc=com"
+    ssl_verify = true
+  }
+}
+`
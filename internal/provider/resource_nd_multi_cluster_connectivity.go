@@ -9,12 +9,14 @@ import (
 	"time"
 
 	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
 	"github.com/Jeffail/gabs/v2"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
@@ -31,8 +33,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ClusterResource{}
 var _ resource.ResourceWithImportState = &ClusterResource{}
-
-var clusterPath = "/api/v1/infra/clusters"
+var _ resource.ResourceWithIdentity = &ClusterResource{}
 
 func NewClusterResource() resource.Resource {
 	return &ClusterResource{}
@@ -64,6 +65,11 @@ type ClusterResourceModel struct {
 	TelemetryNetwork           types.String  `tfsdk:"telemetry_network"`
 }
 
+// ClusterIdentityModel describes the resource identity data model, the fabric name is also the import ID of the resource.
+type ClusterIdentityModel struct {
+	FabricName types.String `tfsdk:"fabric_name"`
+}
+
 func getBaseClusterResourceModel(username, password, clusterLoginDomain, multiClusterLoginDomain basetypes.StringValue) *ClusterResourceModel {
 	return &ClusterResourceModel{
 		Id:                         basetypes.NewStringNull(),
@@ -257,7 +263,7 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"validate_peer_certificate": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The validate peer certificate flag of the cluster. This attribute is only applicable when type is set to apic.",
+				MarkdownDescription: "The validate peer certificate flag of the cluster. This attribute is only applicable when type is set to apic. The certificate of the cluster is validated with the CA certificates trusted by ND, the CA of a private PKI is trusted with the nd_trusted_ca resource.",
 				PlanModifiers: []planmodifier.Bool{
 					boolplanmodifier.UseStateForUnknown(),
 				},
@@ -307,6 +313,17 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 	tflog.Debug(ctx, "End schema of resource: nd_multi_cluster_connectivity")
 }
 
+func (r *ClusterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"fabric_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The name of the cluster.",
+			},
+		},
+	}
+}
+
 func (r *ClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	tflog.Debug(ctx, "Start configure of resource: nd_multi_cluster_connectivity")
 	// Prevent panic if the provider has not been configured.
@@ -350,6 +367,11 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	clusterPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureClusters)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	r.client.DoRestRequest(ctx, &resp.Diagnostics, clusterPath, "POST", jsonPayload)
 
 	if resp.Diagnostics.HasError() {
@@ -361,6 +383,7 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ClusterIdentityModel{FabricName: planData.FabricName})...)
 	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_multi_cluster_connectivity with id '%s'", planData.Id.ValueString()))
 }
 
@@ -385,6 +408,7 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
 	} else {
 		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, ClusterIdentityModel{FabricName: stateData.FabricName})...)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_multi_cluster_connectivity with id '%s'", stateData.Id.ValueString()))
@@ -412,6 +436,11 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	clusterPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureClusters)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", clusterPath, planData.Id.ValueString()), "PUT", jsonPayload)
 
 	if resp.Diagnostics.HasError() {
@@ -422,6 +451,7 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ClusterIdentityModel{FabricName: planData.FabricName})...)
 	tflog.Debug(ctx, "End update of resource nd_multi_cluster_connectivity")
 }
 
@@ -453,6 +483,10 @@ func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 	payload, _ := json.Marshal(deletePayload)
 	jsonPayload, _ := gabs.ParseJSON(payload)
+	clusterPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureClusters)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s/remove", clusterPath, stateData.Id.ValueString()), "POST", jsonPayload)
 	if resp.Diagnostics.HasError() {
 		return
@@ -464,7 +498,7 @@ func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 func (r *ClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Start import state of resource: nd_multi_cluster_connectivity")
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("fabric_name"), req, resp)
 
 	var stateData *ClusterResourceModel
 	resp.Diagnostics.Append(resp.State.Get(ctx, &stateData)...)
@@ -548,7 +582,7 @@ func getClusterJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *C
 						telemetryMap["network"] = data.TelemetryNetwork.ValueString()
 					}
 					if !data.InbandEpg.IsNull() && !data.InbandEpg.IsUnknown() && data.InbandEpg.ValueString() != "" {
-						telemetryMap["epg"] = fmt.Sprintf("uni/tn-mgmt/mgmtp-default/inb-%s", data.InbandEpg.ValueString())
+						telemetryMap["epg"] = getInbandEpgDn(data.InbandEpg.ValueString())
 					}
 					if !data.TelemetryStreamingProtocol.IsNull() && !data.TelemetryStreamingProtocol.IsUnknown() {
 						telemetryMap["streamingProtocol"] = data.TelemetryStreamingProtocol.ValueString()
@@ -628,6 +662,10 @@ func getClusterJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *C
 }
 
 func getAndSetResourceClusterAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *ClusterResourceModel) {
+	clusterPath := client.GetPath(ctx, diags, nd.FeatureClusters)
+	if diags.HasError() {
+		return
+	}
 	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", clusterPath, data.Id.ValueString()), "GET", nil)
 	// When creating or updating the object the username, password, clusterLoginDomain, and multiClusterLoginDomain will be stored in the state file.
 	// When importing the object the username, password, clusterLoginDomain, and multiClusterLoginDomain will be set to empty strings in the state file.
@@ -639,7 +677,16 @@ func getAndSetResourceClusterAttributes(ctx context.Context, diags *diag.Diagnos
 	}
 
 	if responseData.Data() != nil {
-		specReadInfo := responseData.Data().(map[string]interface{})["spec"].(map[string]interface{})
+		setClusterAttributes(ctx, data, responseData.Data().(map[string]interface{}))
+	} else {
+		data.Id = basetypes.NewStringNull()
+	}
+}
+
+// setClusterAttributes sets the attributes of the model from the cluster returned by ND.
+func setClusterAttributes(ctx context.Context, data *ClusterResourceModel, clusterData map[string]interface{}) {
+	if clusterData["spec"] != nil {
+		specReadInfo := clusterData["spec"].(map[string]interface{})
 
 		for attributeName, attributeValue := range specReadInfo {
 			if attributeName == "name" {
@@ -677,17 +724,7 @@ func getAndSetResourceClusterAttributes(ctx context.Context, diags *diag.Diagnos
 				if telemetryValueMap["network"] != nil {
 					data.TelemetryNetwork = basetypes.NewStringValue(telemetryValueMap["network"].(string))
 				}
-				epgDn := telemetryValueMap["epg"].(string)
-				epgName := ""
-				if epgDn != "" {
-					epgSeparator := "/inb-"
-					lastIndex := strings.LastIndex(epgDn, epgSeparator)
-					if lastIndex != -1 {
-						epgNameStartIndex := lastIndex + len(epgSeparator)
-						epgName = epgDn[epgNameStartIndex:]
-					}
-				}
-				data.InbandEpg = basetypes.NewStringValue(epgName)
+				data.InbandEpg = basetypes.NewStringValue(getInbandEpgName(telemetryValueMap["epg"].(string)))
 
 				featuresList := []string{}
 				if telemetryValueMap["status"].(string) != "" && telemetryValueMap["status"].(string) == "enabled" {
@@ -701,7 +738,5 @@ func getAndSetResourceClusterAttributes(ctx context.Context, diags *diag.Diagnos
 				data.Features = featuresSet
 			}
 		}
-	} else {
-		data.Id = basetypes.NewStringNull()
 	}
 }
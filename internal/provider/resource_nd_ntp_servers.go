@@ -0,0 +1,469 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NtpServersResource{}
+var _ resource.ResourceWithImportState = &NtpServersResource{}
+
+// ntpServersId is the ID of the nd_ntp_servers resource, the NTP configuration is a single object of the cluster.
+const ntpServersId = "ntp"
+
+var ntpServerType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"host":      types.StringType,
+		"key_id":    types.Int64Type,
+		"preferred": types.BoolType,
+	},
+}
+
+var ntpKeyType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                  types.Int64Type,
+		"key":                 types.StringType,
+		"authentication_type": types.StringType,
+		"trusted":             types.BoolType,
+	},
+}
+
+func NewNtpServersResource() resource.Resource {
+	return &NtpServersResource{}
+}
+
+// NtpServersResource defines the resource implementation.
+type NtpServersResource struct {
+	client *client.Client
+}
+
+// NtpServersResourceModel describes the resource data model.
+type NtpServersResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Servers     types.List   `tfsdk:"servers"`
+	Keys        types.List   `tfsdk:"keys"`
+	KeysVersion types.Int64  `tfsdk:"keys_version"`
+	Vrf         types.String `tfsdk:"vrf"`
+}
+
+// NtpServerModel describes an NTP server of the cluster.
+type NtpServerModel struct {
+	Host      types.String `tfsdk:"host"`
+	KeyId     types.Int64  `tfsdk:"key_id"`
+	Preferred types.Bool   `tfsdk:"preferred"`
+}
+
+// NtpKeyModel describes an NTP authentication key of the cluster.
+type NtpKeyModel struct {
+	Id                 types.Int64  `tfsdk:"id"`
+	Key                types.String `tfsdk:"key"`
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+	Trusted            types.Bool   `tfsdk:"trusted"`
+}
+
+func getBaseNtpServersResourceModel(keysVersion basetypes.Int64Value) *NtpServersResourceModel {
+	return &NtpServersResourceModel{
+		Id:          basetypes.NewStringNull(),
+		Servers:     basetypes.NewListNull(ntpServerType),
+		Keys:        basetypes.NewListNull(ntpKeyType),
+		KeysVersion: keysVersion,
+		Vrf:         basetypes.NewStringNull(),
+	}
+}
+
+func (r *NtpServersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_ntp_servers")
+	resp.TypeName = req.ProviderTypeName + "_ntp_servers"
+	tflog.Debug(ctx, "End metadata of resource: nd_ntp_servers")
+}
+
+func (r *NtpServersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_ntp_servers")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the NTP Servers of the Nexus Dashboard cluster. The NTP configuration captured at creation is restored when the resource is destroyed, except for the keys which are left unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the NTP configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"servers": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The NTP servers of the cluster.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The hostname or IP address of the NTP server.",
+						},
+						"key_id": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "The ID of the key used to authenticate the NTP server.",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"preferred": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the NTP server is preferred over the other servers.",
+						},
+					},
+				},
+			},
+			"keys": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The authentication keys of the NTP servers.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "The ID of the key.",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"key": schema.StringAttribute{
+							Required:            true,
+							Sensitive:           true,
+							WriteOnly:           true,
+							MarkdownDescription: "The value of the key. The key is write-only and never stored in the state, it is sent to ND when the resource is created and when the `keys_version` or the `keys` change.",
+						},
+						"authentication_type": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("md5"),
+							MarkdownDescription: "The authentication type of the key.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("md5", "sha1"),
+							},
+						},
+						"trusted": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+							MarkdownDescription: "Whether the key is trusted.",
+						},
+					},
+				},
+			},
+			"keys_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of the write-only keys, the keys are sent to ND when the version changes. The keys are also sent when the `keys` change.",
+			},
+			"vrf": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("management"),
+				MarkdownDescription: "The network (VRF) of the cluster used to reach the NTP servers.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("management", "data"),
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_ntp_servers")
+}
+
+func (r *NtpServersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_ntp_servers")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_ntp_servers")
+}
+
+func (r *NtpServersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_ntp_servers")
+
+	var planData *NtpServersResourceModel
+	var configData *NtpServersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getNtpServersJsonPayload(ctx, &resp.Diagnostics, planData, configData)
+	comparePayload := getNtpServersJsonPayload(ctx, &resp.Diagnostics, planData, nil)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The current configuration is captured before it is changed, it is restored when the resource is destroyed.
+	// The values of the keys are not returned by ND, the keys are always sent when they are configured.
+	currentData := putClusterConfig(ctx, &resp.Diagnostics, r.client, nd.FeatureNTPServers, jsonPayload, comparePayload, !planData.Keys.IsNull())
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if currentData.Data() != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, previousConfigKey, currentData.Bytes())...)
+	}
+
+	planData.Id = basetypes.NewStringValue(ntpServersId)
+	getAndSetNtpServersAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_ntp_servers with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *NtpServersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_ntp_servers")
+	var stateData *NtpServersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_ntp_servers with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetNtpServersAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *NtpServersResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_ntp_servers with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *NtpServersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_ntp_servers")
+
+	var planData *NtpServersResourceModel
+	var stateData *NtpServersResourceModel
+	var configData *NtpServersResourceModel
+
+	// Read Terraform plan data and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_ntp_servers with id '%s'", planData.Id.ValueString()))
+
+	keysData := getNtpServersKeysData(planData, stateData, configData)
+	jsonPayload := getNtpServersJsonPayload(ctx, &resp.Diagnostics, planData, keysData)
+	comparePayload := getNtpServersJsonPayload(ctx, &resp.Diagnostics, planData, nil)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	putClusterConfig(ctx, &resp.Diagnostics, r.client, nd.FeatureNTPServers, jsonPayload, comparePayload, keysData != nil && !planData.Keys.IsNull())
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetNtpServersAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_ntp_servers")
+}
+
+func (r *NtpServersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_ntp_servers")
+	var stateData *NtpServersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_ntp_servers with id '%s'", stateData.Id.ValueString()))
+
+	previousConfig, diags := req.Private.GetKey(ctx, previousConfigKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The values of the keys are not returned by ND, the keys are omitted so that ND keeps the current keys.
+	restoreClusterConfig(ctx, &resp.Diagnostics, r.client, nd.FeatureNTPServers, previousConfig, "/keys")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_ntp_servers with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *NtpServersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_ntp_servers")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_ntp_servers with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_ntp_servers")
+}
+
+// getNtpServersKeysData returns the configuration from which the write-only keys are sent, nil is returned when the
+// keys are not sent. The keys are only sent when the keys version changes, ND keeps the current keys when they are not
+// provided. The keys are also sent when the keys change, because a new or changed key would be sent without its value.
+func getNtpServersKeysData(planData, stateData, configData *NtpServersResourceModel) *NtpServersResourceModel {
+	if planData.KeysVersion.Equal(stateData.KeysVersion) && planData.Keys.Equal(stateData.Keys) {
+		return nil
+	}
+	return configData
+}
+
+// getNtpServersJsonPayload returns the payload of the NTP configuration, the write-only keys are read from the
+// configuration and their values are omitted when the configuration is nil.
+func getNtpServersJsonPayload(ctx context.Context, diags *diag.Diagnostics, data, configData *NtpServersResourceModel) *gabs.Container {
+	payload := gabs.New()
+
+	var servers []NtpServerModel
+	diags.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	serversPayload := make([]map[string]interface{}, 0, len(servers))
+	for _, server := range servers {
+		serverPayload := map[string]interface{}{
+			"host":      server.Host.ValueString(),
+			"preferred": server.Preferred.ValueBool(),
+		}
+		if !server.KeyId.IsNull() && !server.KeyId.IsUnknown() {
+			serverPayload["keyID"] = server.KeyId.ValueInt64()
+		}
+		serversPayload = append(serversPayload, serverPayload)
+	}
+	setPayloadValue(diags, payload, serversPayload, "/servers")
+
+	var keys, keysConfig []NtpKeyModel
+	if !data.Keys.IsNull() && !data.Keys.IsUnknown() {
+		diags.Append(data.Keys.ElementsAs(ctx, &keys, false)...)
+	}
+	if configData != nil && !configData.Keys.IsNull() && !configData.Keys.IsUnknown() {
+		diags.Append(configData.Keys.ElementsAs(ctx, &keysConfig, false)...)
+	}
+	keysPayload := make([]map[string]interface{}, 0, len(keys))
+	for index, key := range keys {
+		keyPayload := map[string]interface{}{
+			"id":       key.Id.ValueInt64(),
+			"authType": key.AuthenticationType.ValueString(),
+			"trusted":  key.Trusted.ValueBool(),
+		}
+		if index < len(keysConfig) && !keysConfig[index].Key.IsNull() && !keysConfig[index].Key.IsUnknown() {
+			keyPayload["key"] = keysConfig[index].Key.ValueString()
+		}
+		keysPayload = append(keysPayload, keyPayload)
+	}
+	setPayloadValue(diags, payload, keysPayload, "/keys")
+	setPayloadValue(diags, payload, data.Vrf.ValueString(), "/vrf")
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+func getAndSetNtpServersAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *NtpServersResourceModel) {
+	responseData := getClusterConfig(ctx, diags, client, nd.FeatureNTPServers)
+	// The API does not return the keys, the keys_version is assigned based on the user's configuration settings.
+	*data = *getBaseNtpServersResourceModel(data.KeysVersion)
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(ntpServersId)
+	data.Vrf = basetypes.NewStringValue(getResponseString(responseData, "/vrf"))
+
+	servers := []NtpServerModel{}
+	for _, serverData := range responseData.S("servers").Children() {
+		server := NtpServerModel{
+			Host:      basetypes.NewStringValue(getResponseString(serverData, "/host")),
+			KeyId:     basetypes.NewInt64Null(),
+			Preferred: basetypes.NewBoolValue(getResponseValue(serverData, "/preferred") == true),
+		}
+		if keyId := getResponseInt64(serverData, "/keyID"); keyId != 0 {
+			server.KeyId = basetypes.NewInt64Value(keyId)
+		}
+		servers = append(servers, server)
+	}
+	serversList, listDiags := types.ListValueFrom(ctx, ntpServerType, servers)
+	diags.Append(listDiags...)
+	data.Servers = serversList
+
+	keys := []NtpKeyModel{}
+	for _, keyData := range responseData.S("keys").Children() {
+		keys = append(keys, NtpKeyModel{
+			Id:                 basetypes.NewInt64Value(getResponseInt64(keyData, "/id")),
+			Key:                basetypes.NewStringNull(),
+			AuthenticationType: basetypes.NewStringValue(getResponseString(keyData, "/authType")),
+			Trusted:            basetypes.NewBoolValue(getResponseValue(keyData, "/trusted") == true),
+		})
+	}
+	// The keys are optional, no keys are stored as null to match a configuration without keys.
+	if len(keys) > 0 {
+		keysList, listDiags := types.ListValueFrom(ctx, ntpKeyType, keys)
+		diags.Append(listDiags...)
+		data.Keys = keysList
+	}
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceNdNtpServers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdNtpServersCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "id", "ntp"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "servers.#", "1"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "servers.0.host", "10.0.0.1"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "servers.0.preferred", "false"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "vrf", "management"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_ntp_servers.test",
+				ImportState:       true,
+				ImportStateId:     "ntp",
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdNtpServersUpdate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "servers.#", "2"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "servers.0.host", "10.0.0.1"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "servers.0.key_id", "1"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "servers.0.preferred", "true"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "servers.1.host", "10.0.0.2"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "keys.#", "1"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "keys.0.id", "1"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "keys.0.authentication_type", "sha1"),
+					resource.TestCheckResourceAttr("nd_ntp_servers.test", "keys.0.trusted", "true"),
+					resource.TestCheckNoResourceAttr("nd_ntp_servers.test", "keys.0.key"),
+				),
+			},
+		},
+	})
+}
+
+func TestNtpServersAttributes(t *testing.T) {
+	puts := []string{}
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/infra/clusterConfig/ntp" && r.Method == "PUT" {
+			body, _ := io.ReadAll(r.Body)
+			puts = append(puts, string(body))
+			io.WriteString(w, string(body))
+			return
+		}
+		if r.URL.Path == "/api/v1/infra/clusterConfig/ntp" {
+			fmt.Fprint(w, `{"servers": [{"host": "10.0.0.1", "keyID": 1, "preferred": true}, {"host": "10.0.0.2"}], "keys": [{"id": 1, "authType": "sha1", "trusted": true}], "vrf": "data"}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	var diags diag.Diagnostics
+	data := getBaseNtpServersResourceModel(basetypes.NewInt64Value(2))
+	getAndSetNtpServersAttributes(context.Background(), &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Id.ValueString() != "ntp" || data.Vrf.ValueString() != "data" || data.KeysVersion.ValueInt64() != 2 {
+		t.Errorf("unexpected id %s, vrf %s and keys_version %s", data.Id, data.Vrf, data.KeysVersion)
+	}
+	var servers []NtpServerModel
+	diags.Append(data.Servers.ElementsAs(context.Background(), &servers, false)...)
+	if len(servers) != 2 || servers[0].KeyId.ValueInt64() != 1 || !servers[0].Preferred.ValueBool() || !servers[1].KeyId.IsNull() || servers[1].Preferred.ValueBool() {
+		t.Errorf("unexpected servers %v", servers)
+	}
+	var keys []NtpKeyModel
+	diags.Append(data.Keys.ElementsAs(context.Background(), &keys, false)...)
+	if len(keys) != 1 || keys[0].Id.ValueInt64() != 1 || keys[0].AuthenticationType.ValueString() != "sha1" || !keys[0].Key.IsNull() {
+		t.Errorf("unexpected keys %v", keys)
+	}
+
+	payload := getNtpServersJsonPayload(context.Background(), &diags, data, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	current := getClusterConfig(context.Background(), &diags, client, nd.FeatureNTPServers)
+	if clusterConfigChanged(current, payload) {
+		t.Errorf("expected the payload %s to match the current configuration %s", payload, current)
+	}
+
+	// The keys are omitted from the restored configuration because their values are not returned by ND.
+	restoreClusterConfig(context.Background(), &diags, client, nd.FeatureNTPServers, current.Bytes(), "/keys")
+	if diags.HasError() || len(puts) != 1 || strings.Contains(puts[0], "keys") || !strings.Contains(puts[0], `"vrf":"data"`) {
+		t.Errorf("expected the previous configuration to be restored without the keys, got %v: %v", puts, diags)
+	}
+}
+
+func TestNtpServersKeysData(t *testing.T) {
+	ctx := context.Background()
+	ntpServers := func(withValues bool, ids ...int64) *NtpServersResourceModel {
+		keys := make([]NtpKeyModel, 0, len(ids))
+		for _, id := range ids {
+			key := NtpKeyModel{Id: basetypes.NewInt64Value(id), Key: basetypes.NewStringNull(), AuthenticationType: basetypes.NewStringValue("sha1"), Trusted: basetypes.NewBoolValue(true)}
+			if withValues {
+				key.Key = basetypes.NewStringValue(fmt.Sprintf("key%d", id))
+			}
+			keys = append(keys, key)
+		}
+		keysList, diags := types.ListValueFrom(ctx, ntpKeyType, keys)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		servers, diags := types.ListValueFrom(ctx, ntpServerType, []NtpServerModel{{Host: basetypes.NewStringValue("10.0.0.1"), KeyId: basetypes.NewInt64Value(1), Preferred: basetypes.NewBoolValue(false)}})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		data := getBaseNtpServersResourceModel(basetypes.NewInt64Value(1))
+		data.Id = basetypes.NewStringValue(ntpServersId)
+		data.Servers = servers
+		data.Keys = keysList
+		data.Vrf = basetypes.NewStringValue("management")
+		return data
+	}
+
+	stateData := ntpServers(false, 1)
+	if keysData := getNtpServersKeysData(ntpServers(false, 1), stateData, ntpServers(true, 1)); keysData != nil {
+		t.Errorf("expected the keys not to be sent when the keys and the keys_version are unchanged")
+	}
+
+	// A key added with the same keys_version is sent with its value.
+	planData, configData := ntpServers(false, 1, 2), ntpServers(true, 1, 2)
+	keysData := getNtpServersKeysData(planData, stateData, configData)
+	if keysData == nil {
+		t.Fatalf("expected the keys to be sent when the keys change with the same keys_version")
+	}
+	var diags diag.Diagnostics
+	payload := getNtpServersJsonPayload(ctx, &diags, planData, keysData)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !strings.Contains(payload.String(), `"key":"key1"`) || !strings.Contains(payload.String(), `"key":"key2"`) {
+		t.Errorf("expected the values of the keys to be sent, got %s", payload.String())
+	}
+}
+
+const testConfigResourceNdNtpServersCreate = `
+resource "nd_ntp_servers" "test" {
+  servers = [
+    {
+      host = "10.0.0.1"
+    }
+  ]
+}
+`
+
+const testConfigResourceNdNtpServersUpdate = `
+resource "nd_ntp_servers" "test" {
+  servers = [
+    {
+      host      = "10.0.0.1"
+      key_id    = 1
+      preferred = true
+    },
+    {
+      host = "10.0.0.2"
+    }
+  ]
+  keys = [
+    {
+      id                  = 1
+      key                 = "ntp_key"
+      authentication_type = "sha1"
+    }
+  ]
+  keys_version = 1
+}
+`
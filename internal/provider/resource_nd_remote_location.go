@@ -0,0 +1,494 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RemoteLocationResource{}
+var _ resource.ResourceWithImportState = &RemoteLocationResource{}
+var _ resource.ResourceWithValidateConfig = &RemoteLocationResource{}
+
+// remoteLocationAuthenticationTypes maps the authentication types of the resource to the authentication types of ND.
+var remoteLocationAuthenticationTypes = map[string]string{
+	"password": "password",
+	"ssh_key":  "sshKey",
+}
+
+func NewRemoteLocationResource() resource.Resource {
+	return &RemoteLocationResource{}
+}
+
+// RemoteLocationResource defines the resource implementation.
+type RemoteLocationResource struct {
+	client *client.Client
+}
+
+// RemoteLocationResourceModel describes the resource data model.
+type RemoteLocationResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Protocol           types.String `tfsdk:"protocol"`
+	Host               types.String `tfsdk:"host"`
+	Port               types.Int64  `tfsdk:"port"`
+	Path               types.String `tfsdk:"path"`
+	Username           types.String `tfsdk:"username"`
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+	Password           types.String `tfsdk:"password"`
+	SshKey             types.String `tfsdk:"ssh_key"`
+	EncryptionKey      types.String `tfsdk:"encryption_key"`
+	SecretsVersion     types.Int64  `tfsdk:"secrets_version"`
+}
+
+func getBaseRemoteLocationResourceModel(secretsVersion basetypes.Int64Value) *RemoteLocationResourceModel {
+	return &RemoteLocationResourceModel{
+		Id:                 basetypes.NewStringNull(),
+		Name:               basetypes.NewStringNull(),
+		Description:        basetypes.NewStringNull(),
+		Protocol:           basetypes.NewStringNull(),
+		Host:               basetypes.NewStringNull(),
+		Port:               basetypes.NewInt64Null(),
+		Path:               basetypes.NewStringNull(),
+		Username:           basetypes.NewStringNull(),
+		AuthenticationType: basetypes.NewStringNull(),
+		Password:           basetypes.NewStringNull(),
+		SshKey:             basetypes.NewStringNull(),
+		EncryptionKey:      basetypes.NewStringNull(),
+		SecretsVersion:     secretsVersion,
+	}
+}
+
+func (r *RemoteLocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_remote_location")
+	resp.TypeName = req.ProviderTypeName + "_remote_location"
+	tflog.Debug(ctx, "End metadata of resource: nd_remote_location")
+}
+
+func (r *RemoteLocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_remote_location")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Remote Locations of the configuration backups of Nexus Dashboard.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the remote location.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the remote location.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The description of the remote location.",
+			},
+			"protocol": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The protocol used to transfer the backups to the remote location.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("sftp", "scp", "nas"),
+				},
+			},
+			"host": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The hostname or IP address of the remote location.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(22),
+				MarkdownDescription: "The port of the remote location, the port is not used by the `nas` protocol.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The path of the directory of the backups on the remote location, or the export path of the `nas` protocol.",
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The username used to authenticate to the remote location, required by the `sftp` and `scp` protocols.",
+			},
+			"authentication_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("password"),
+				MarkdownDescription: "The authentication type of the `sftp` and `scp` protocols.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("password", "ssh_key"),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The password used to authenticate to the remote location when the `authentication_type` is 'password'. The password is write-only and never stored in the state, it is sent to ND when the remote location is created and when the `secrets_version` or the `authentication_type` changes.",
+			},
+			"ssh_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The private SSH key used to authenticate to the remote location when the `authentication_type` is 'ssh_key'. The key is write-only and never stored in the state, it is sent to ND when the remote location is created and when the `secrets_version` or the `authentication_type` changes.",
+			},
+			"encryption_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The key used to encrypt the backups stored on the remote location. The key is write-only and never stored in the state, it is sent to ND when the remote location is created and when the `secrets_version` or the `authentication_type` changes.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+				},
+			},
+			"secrets_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of the write-only secrets, the secrets are sent to ND when the version changes.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_remote_location")
+}
+
+func (r *RemoteLocationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData *RemoteLocationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() || configData.Protocol.IsUnknown() || configData.Protocol.IsNull() || configData.AuthenticationType.IsUnknown() {
+		return
+	}
+
+	protocol := configData.Protocol.ValueString()
+	if protocol == "nas" {
+		credentials := map[string]types.String{
+			"username":            configData.Username,
+			"authentication_type": configData.AuthenticationType,
+			"password":            configData.Password,
+			"ssh_key":             configData.SshKey,
+		}
+		for name, value := range credentials {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					fmt.Sprintf("Invalid %s", name),
+					fmt.Sprintf("The '%s' attribute is invalid when the 'protocol' is 'nas'.", name),
+				)
+			}
+		}
+		if !configData.Port.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("port"),
+				"Invalid port",
+				"The 'port' attribute is invalid when the 'protocol' is 'nas'.",
+			)
+		}
+		return
+	}
+
+	if configData.Username.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Missing username",
+			fmt.Sprintf("The 'username' attribute is required when the 'protocol' is '%s'.", protocol),
+		)
+	}
+
+	authenticationType := configData.AuthenticationType.ValueString()
+	if authenticationType == "" {
+		authenticationType = "password"
+	}
+	secrets := map[string]types.String{
+		"password": configData.Password,
+		"ssh_key":  configData.SshKey,
+	}
+	for name, value := range secrets {
+		if name == authenticationType && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				fmt.Sprintf("Missing %s", name),
+				fmt.Sprintf("The '%s' attribute is required when the 'authentication_type' is '%s'.", name, authenticationType),
+			)
+		} else if name != authenticationType && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				fmt.Sprintf("Invalid %s", name),
+				fmt.Sprintf("The '%s' attribute is invalid when the 'authentication_type' is '%s'.", name, authenticationType),
+			)
+		}
+	}
+}
+
+func (r *RemoteLocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_remote_location")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_remote_location")
+}
+
+func (r *RemoteLocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_remote_location")
+
+	var planData *RemoteLocationResourceModel
+	var configData *RemoteLocationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getRemoteLocationJsonPayload(&resp.Diagnostics, planData, configData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureRemoteLocations)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "POST", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = types.StringValue(planData.Name.ValueString())
+	getAndSetRemoteLocationAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_remote_location with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *RemoteLocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_remote_location")
+	var stateData *RemoteLocationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_remote_location with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetRemoteLocationAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *RemoteLocationResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_remote_location with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *RemoteLocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_remote_location")
+
+	var planData *RemoteLocationResourceModel
+	var stateData *RemoteLocationResourceModel
+	var configData *RemoteLocationResourceModel
+
+	// Read Terraform plan data and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_remote_location with id '%s'", planData.Id.ValueString()))
+
+	// The secrets are only sent when the secrets version changes, ND keeps the current secrets when they are not provided.
+	// The secrets are also sent when the authentication type changes, because ND requires the credential of the new type.
+	secretsData := configData
+	if planData.SecretsVersion.Equal(stateData.SecretsVersion) && planData.AuthenticationType.Equal(stateData.AuthenticationType) {
+		secretsData = nil
+	}
+
+	jsonPayload := getRemoteLocationJsonPayload(&resp.Diagnostics, planData, secretsData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureRemoteLocations)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, planData.Id.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetRemoteLocationAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_remote_location")
+}
+
+func (r *RemoteLocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_remote_location")
+	var stateData *RemoteLocationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_remote_location with id '%s'", stateData.Id.ValueString()))
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureRemoteLocations)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, stateData.Id.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_remote_location with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *RemoteLocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_remote_location")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_remote_location with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_remote_location")
+}
+
+// getRemoteLocationJsonPayload returns the payload of the remote location, the write-only secrets are read from the
+// configuration and are omitted when the configuration is nil.
+func getRemoteLocationJsonPayload(diags *diag.Diagnostics, data, configData *RemoteLocationResourceModel) *gabs.Container {
+	payload := gabs.New()
+
+	setPayloadValue(diags, payload, data.Name.ValueString(), "/name")
+	setPayloadValue(diags, payload, data.Description.ValueString(), "/description")
+	setPayloadValue(diags, payload, data.Protocol.ValueString(), "/protocol")
+	setPayloadValue(diags, payload, data.Host.ValueString(), "/host")
+	setPayloadValue(diags, payload, data.Path.ValueString(), "/path")
+	if data.Protocol.ValueString() != "nas" {
+		setPayloadValue(diags, payload, data.Port.ValueInt64(), "/port")
+		setPayloadValue(diags, payload, data.Username.ValueString(), "/username")
+		setPayloadValue(diags, payload, remoteLocationAuthenticationTypes[data.AuthenticationType.ValueString()], "/authType")
+	}
+
+	if configData != nil {
+		secrets := map[string]types.String{
+			"/password":      configData.Password,
+			"/sshKey":        configData.SshKey,
+			"/encryptionKey": configData.EncryptionKey,
+		}
+		for pointer, value := range secrets {
+			if !value.IsNull() && !value.IsUnknown() {
+				setPayloadValue(diags, payload, value.ValueString(), pointer)
+			}
+		}
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+func getAndSetRemoteLocationAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *RemoteLocationResourceModel) {
+	apiPath := client.GetPath(ctx, diags, nd.FeatureRemoteLocations)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "GET", nil)
+	// The API does not return the secrets, the secrets_version is assigned based on the user's configuration settings.
+	*data = *getBaseRemoteLocationResourceModel(data.SecretsVersion)
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		return
+	}
+
+	name := getResponseString(responseData, "/name")
+	protocol := getResponseString(responseData, "/protocol")
+	data.Id = basetypes.NewStringValue(name)
+	data.Name = basetypes.NewStringValue(name)
+	data.Description = basetypes.NewStringValue(getResponseString(responseData, "/description"))
+	data.Protocol = basetypes.NewStringValue(protocol)
+	data.Host = basetypes.NewStringValue(getResponseString(responseData, "/host"))
+	data.Path = basetypes.NewStringValue(getResponseString(responseData, "/path"))
+
+	// The port and credentials are not used by the nas protocol, the defaults of the attributes are kept.
+	data.Port = basetypes.NewInt64Value(22)
+	data.AuthenticationType = basetypes.NewStringValue("password")
+	if protocol != "nas" {
+		data.Port = basetypes.NewInt64Value(getResponseInt64(responseData, "/port"))
+		data.Username = basetypes.NewStringValue(getResponseString(responseData, "/username"))
+		for authenticationType, value := range remoteLocationAuthenticationTypes {
+			if value == getResponseString(responseData, "/authType") {
+				data.AuthenticationType = basetypes.NewStringValue(authenticationType)
+			}
+		}
+	}
+}
@@ -0,0 +1,415 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleResource{}
+var _ resource.ResourceWithImportState = &RoleResource{}
+
+// rolePrivilegeType is the type of the elements of the privileges set.
+var rolePrivilegeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":   types.StringType,
+		"access": types.StringType,
+	},
+}
+
+func NewRoleResource() resource.Resource {
+	return &RoleResource{}
+}
+
+// RoleResource defines the resource implementation.
+type RoleResource struct {
+	client *client.Client
+}
+
+// RoleResourceModel describes the resource data model.
+type RoleResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Privileges  types.Set    `tfsdk:"privileges"`
+}
+
+// RolePrivilegeModel describes the access level of a role to a privilege.
+type RolePrivilegeModel struct {
+	Name   types.String `tfsdk:"name"`
+	Access types.String `tfsdk:"access"`
+}
+
+// rolePrivilegeNamesValidator validates that each privilege of a role is only configured once, ND keeps a single access
+// level per privilege.
+type rolePrivilegeNamesValidator struct{}
+
+var _ validator.Set = rolePrivilegeNamesValidator{}
+
+func (v rolePrivilegeNamesValidator) Description(ctx context.Context) string {
+	return "each privilege name must be unique"
+}
+
+func (v rolePrivilegeNamesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rolePrivilegeNamesValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var privileges []RolePrivilegeModel
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &privileges, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make(map[string]bool, len(privileges))
+	for _, privilege := range privileges {
+		if privilege.Name.IsNull() || privilege.Name.IsUnknown() {
+			continue
+		}
+		name := privilege.Name.ValueString()
+		if names[name] {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Duplicate privilege",
+				fmt.Sprintf("The privilege '%s' is configured more than once, each privilege can only have one access level.", name),
+			)
+		}
+		names[name] = true
+	}
+}
+
+func getBaseRoleResourceModel() *RoleResourceModel {
+	return &RoleResourceModel{
+		Id:          basetypes.NewStringNull(),
+		Name:        basetypes.NewStringNull(),
+		Description: basetypes.NewStringNull(),
+		Privileges:  basetypes.NewSetNull(rolePrivilegeType),
+	}
+}
+
+func (r *RoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_role")
+	resp.TypeName = req.ProviderTypeName + "_role"
+	tflog.Debug(ctx, "End metadata of resource: nd_role")
+}
+
+func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_role")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Custom Roles for Nexus Dashboard",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The description of the role.",
+			},
+			"privileges": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The privileges of the role with their access levels, each privilege can only be configured once.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					rolePrivilegeNamesValidator{},
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the privilege, ie 'fabricManagement'.",
+						},
+						"access": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The access level of the role to the privilege.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("read", "write"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_role")
+}
+
+func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_role")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_role")
+}
+
+func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_role")
+
+	var planData *RoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getRoleJsonPayload(ctx, &resp.Diagnostics, planData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureRoles)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "POST", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = types.StringValue(planData.Name.ValueString())
+	getAndSetRoleAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_role with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_role")
+	var stateData *RoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_role with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetRoleAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *RoleResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_role with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_role")
+
+	var planData *RoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_role with id '%s'", planData.Id.ValueString()))
+
+	jsonPayload := getRoleJsonPayload(ctx, &resp.Diagnostics, planData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureRoles)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, planData.Id.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetRoleAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_role")
+}
+
+func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_role")
+	var stateData *RoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_role with id '%s'", stateData.Id.ValueString()))
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureRoles)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, stateData.Id.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_role with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_role")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_role with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_role")
+}
+
+func getRoleJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *RoleResourceModel) *gabs.Container {
+	payload := gabs.New()
+
+	setPayloadValue(diags, payload, data.Name.ValueString(), "/name")
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		setPayloadValue(diags, payload, data.Description.ValueString(), "/description")
+	}
+
+	if !data.Privileges.IsNull() && !data.Privileges.IsUnknown() {
+		var privileges []RolePrivilegeModel
+		diags.Append(data.Privileges.ElementsAs(ctx, &privileges, false)...)
+		privilegesPayload := make([]map[string]interface{}, 0, len(privileges))
+		for _, privilege := range privileges {
+			privilegesPayload = append(privilegesPayload, map[string]interface{}{
+				"name":   privilege.Name.ValueString(),
+				"access": privilege.Access.ValueString(),
+			})
+		}
+		sort.Slice(privilegesPayload, func(i, j int) bool {
+			return privilegesPayload[i]["name"].(string) < privilegesPayload[j]["name"].(string)
+		})
+		setPayloadValue(diags, payload, privilegesPayload, "/privileges")
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+func getAndSetRoleAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *RoleResourceModel) {
+	apiPath := client.GetPath(ctx, diags, nd.FeatureRoles)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "GET", nil)
+	*data = *getBaseRoleResourceModel()
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() != nil {
+		roleData := getRoleDataModel(ctx, diags, responseData)
+		data.Id = roleData.Id
+		data.Name = roleData.Name
+		data.Description = roleData.Description
+		data.Privileges = roleData.Privileges
+	} else {
+		data.Id = basetypes.NewStringNull()
+	}
+}
+
+// getRoleDataModel returns the attributes of the role returned by ND.
+func getRoleDataModel(ctx context.Context, diags *diag.Diagnostics, responseData *gabs.Container) RoleDataModel {
+	data := RoleDataModel{
+		Id:          basetypes.NewStringNull(),
+		Name:        basetypes.NewStringNull(),
+		Description: basetypes.NewStringValue(""),
+		BuiltIn:     basetypes.NewBoolValue(false),
+		Privileges:  basetypes.NewSetValueMust(rolePrivilegeType, []attr.Value{}),
+	}
+
+	if value, ok := getResponseValue(responseData, "/name").(string); ok {
+		data.Id = basetypes.NewStringValue(value)
+		data.Name = basetypes.NewStringValue(value)
+	}
+	if value, ok := getResponseValue(responseData, "/description").(string); ok {
+		data.Description = basetypes.NewStringValue(value)
+	}
+	if value, ok := getResponseValue(responseData, "/builtIn").(bool); ok {
+		data.BuiltIn = basetypes.NewBoolValue(value)
+	}
+
+	if privilegesData, err := responseData.JSONPointer("/privileges"); err == nil {
+		privileges := []RolePrivilegeModel{}
+		for _, privilegeData := range privilegesData.Children() {
+			privileges = append(privileges, RolePrivilegeModel{
+				Name:   basetypes.NewStringValue(getResponseString(privilegeData, "/name")),
+				Access: basetypes.NewStringValue(getResponseString(privilegeData, "/access")),
+			})
+		}
+		privilegesSet, setDiags := types.SetValueFrom(ctx, rolePrivilegeType, privileges)
+		diags.Append(setDiags...)
+		data.Privileges = privilegesSet
+	}
+	return data
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceNdRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdRoleCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_role.test", "id", "terraform_role"),
+					resource.TestCheckResourceAttr("nd_role.test", "name", "terraform_role"),
+					resource.TestCheckResourceAttr("nd_role.test", "description", ""),
+					resource.TestCheckResourceAttr("nd_role.test", "privileges.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("nd_role.test", "privileges.*", map[string]string{"name": "fabricManagement", "access": "read"}),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_role.test",
+				ImportState:       true,
+				ImportStateId:     "terraform_role",
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdRoleUpdate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_role.test", "id", "terraform_role"),
+					resource.TestCheckResourceAttr("nd_role.test", "description", "Created by Terraform"),
+					resource.TestCheckResourceAttr("nd_role.test", "privileges.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("nd_role.test", "privileges.*", map[string]string{"name": "fabricManagement", "access": "write"}),
+					resource.TestCheckTypeSetElemNestedAttrs("nd_role.test", "privileges.*", map[string]string{"name": "eventManagement", "access": "read"}),
+				),
+			},
+		},
+	})
+}
+
+// Validate Privilege Errors
+func TestAccResourceNdRoleError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfigResourceNdRoleDuplicatePrivilegeError,
+				ExpectError: regexp.MustCompile("The privilege 'fabricManagement' is configured more than once"),
+			},
+		},
+	})
+}
+
+func TestRolePrivilegeNamesValidator(t *testing.T) {
+	ctx := context.Background()
+	privileges := func(values ...RolePrivilegeModel) types.Set {
+		privilegesSet, diags := types.SetValueFrom(ctx, rolePrivilegeType, values)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return privilegesSet
+	}
+	read := RolePrivilegeModel{Name: basetypes.NewStringValue("fabricManagement"), Access: basetypes.NewStringValue("read")}
+	write := RolePrivilegeModel{Name: basetypes.NewStringValue("fabricManagement"), Access: basetypes.NewStringValue("write")}
+	other := RolePrivilegeModel{Name: basetypes.NewStringValue("eventManagement"), Access: basetypes.NewStringValue("write")}
+
+	for _, test := range []struct {
+		value         types.Set
+		expectedError bool
+	}{
+		{value: privileges(read, other), expectedError: false},
+		{value: privileges(read, write), expectedError: true},
+		{value: basetypes.NewSetUnknown(rolePrivilegeType), expectedError: false},
+	} {
+		resp := &validator.SetResponse{}
+		rolePrivilegeNamesValidator{}.ValidateSet(ctx, validator.SetRequest{Path: path.Root("privileges"), ConfigValue: test.value}, resp)
+		if resp.Diagnostics.HasError() != test.expectedError {
+			t.Errorf("expected error %t for %s, got %v", test.expectedError, test.value, resp.Diagnostics)
+		}
+	}
+}
+
+func TestRoleAttributes(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/aaa/roles/role1":
+			fmt.Fprint(w, `{"name": "role1", "builtIn": false, "privileges": [{"name": "fabricManagement", "access": "write"}, {"name": "eventManagement", "access": "read"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var diags diag.Diagnostics
+	data := getBaseRoleResourceModel()
+	data.Id = basetypes.NewStringValue("role1")
+	getAndSetRoleAttributes(context.Background(), &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Id.ValueString() != "role1" || data.Name.ValueString() != "role1" || data.Description.ValueString() != "" {
+		t.Errorf("unexpected id %s, name %s and description %s", data.Id, data.Name, data.Description)
+	}
+	var privileges []RolePrivilegeModel
+	diags.Append(data.Privileges.ElementsAs(context.Background(), &privileges, false)...)
+	access := map[string]string{}
+	for _, privilege := range privileges {
+		access[privilege.Name.ValueString()] = privilege.Access.ValueString()
+	}
+	if len(access) != 2 || access["fabricManagement"] != "write" || access["eventManagement"] != "read" {
+		t.Errorf("unexpected privileges %v", access)
+	}
+
+	data.Id = basetypes.NewStringValue("role2")
+	getAndSetRoleAttributes(context.Background(), &diags, client, data)
+	if diags.HasError() || !data.Id.IsNull() {
+		t.Errorf("expected a null id for a deleted role, got %s: %v", data.Id, diags)
+	}
+}
+
+const testConfigResourceNdRoleCreate = `
+resource "nd_role" "test" {
+  name = "terraform_role"
+  privileges = [
+    {
+      name   = "fabricManagement"
+      access = "read"
+    }
+  ]
+}
+`
+
+const testConfigResourceNdRoleUpdate = `
+resource "nd_role" "test" {
+  name        = "terraform_role"
+  description = "Created by Terraform"
+  privileges = [
+    {
+      name   = "fabricManagement"
+      access = "write"
+    },
+    {
+      name   = "eventManagement"
+      access = "read"
+    }
+  ]
+}
+`
+
+const testConfigResourceNdRoleDuplicatePrivilegeError = `
+resource "nd_role" "test" {
+  name = "terraform_role"
+  privileges = [
+    {
+      name   = "fabricManagement"
+      access = "read"
+    },
+    {
+      name   = "fabricManagement"
+      access = "write"
+    }
+  ]
+}
+`
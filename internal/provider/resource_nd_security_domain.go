@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecurityDomainResource{}
+var _ resource.ResourceWithImportState = &SecurityDomainResource{}
+var _ resource.ResourceWithIdentity = &SecurityDomainResource{}
+
+func NewSecurityDomainResource() resource.Resource {
+	return &SecurityDomainResource{}
+}
+
+// SecurityDomainResource defines the resource implementation.
+type SecurityDomainResource struct {
+	client *client.Client
+}
+
+// SecurityDomainResourceModel describes the resource data model.
+type SecurityDomainResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Restricted  types.Bool   `tfsdk:"restricted"`
+	Fabrics     types.Set    `tfsdk:"fabrics"`
+}
+
+// SecurityDomainIdentityModel describes the resource identity data model, the name is also the import ID of the resource.
+type SecurityDomainIdentityModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func getBaseSecurityDomainResourceModel() *SecurityDomainResourceModel {
+	return &SecurityDomainResourceModel{
+		Id:          basetypes.NewStringNull(),
+		Name:        basetypes.NewStringNull(),
+		Description: basetypes.NewStringNull(),
+		Restricted:  basetypes.NewBoolNull(),
+		Fabrics:     basetypes.NewSetNull(types.StringType),
+	}
+}
+
+func (r *SecurityDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_security_domain")
+	resp.TypeName = req.ProviderTypeName + "_security_domain"
+	tflog.Debug(ctx, "End metadata of resource: nd_security_domain")
+}
+
+func (r *SecurityDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_security_domain")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Security Domains for Nexus Dashboard",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the security domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the security domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The description of the security domain.",
+			},
+			"restricted": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "The restricted flag of the security domain, the objects of a restricted security domain are only visible to the users of the security domain.",
+			},
+			"fabrics": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "The names of the sites or fabrics linked to the security domain.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_security_domain")
+}
+
+func (r *SecurityDomainResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The name of the security domain.",
+			},
+		},
+	}
+}
+
+func (r *SecurityDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_security_domain")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_security_domain")
+}
+
+func (r *SecurityDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_security_domain")
+
+	var planData *SecurityDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getSecurityDomainJsonPayload(ctx, &resp.Diagnostics, planData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureSecurityDomains)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "POST", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = types.StringValue(planData.Name.ValueString())
+	getAndSetSecurityDomainAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SecurityDomainIdentityModel{Name: planData.Name})...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_security_domain with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *SecurityDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_security_domain")
+	var stateData *SecurityDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_security_domain with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetSecurityDomainAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *SecurityDomainResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, SecurityDomainIdentityModel{Name: stateData.Name})...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_security_domain with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *SecurityDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_security_domain")
+
+	var planData *SecurityDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_security_domain with id '%s'", planData.Id.ValueString()))
+
+	jsonPayload := getSecurityDomainJsonPayload(ctx, &resp.Diagnostics, planData)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureSecurityDomains)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, planData.Id.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetSecurityDomainAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, SecurityDomainIdentityModel{Name: planData.Name})...)
+	tflog.Debug(ctx, "End update of resource nd_security_domain")
+}
+
+func (r *SecurityDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_security_domain")
+	var stateData *SecurityDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_security_domain with id '%s'", stateData.Id.ValueString()))
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureSecurityDomains)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, stateData.Id.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_security_domain with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *SecurityDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_security_domain")
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("name"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_security_domain with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_security_domain")
+}
+
+func getSecurityDomainJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *SecurityDomainResourceModel) *gabs.Container {
+	payload := gabs.New()
+
+	setPayloadValue(diags, payload, data.Name.ValueString(), "/name")
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		setPayloadValue(diags, payload, data.Description.ValueString(), "/description")
+	}
+	if !data.Restricted.IsNull() && !data.Restricted.IsUnknown() {
+		setPayloadValue(diags, payload, data.Restricted.ValueBool(), "/restricted")
+	}
+	if !data.Fabrics.IsNull() && !data.Fabrics.IsUnknown() {
+		fabrics := make([]string, 0)
+		diags.Append(data.Fabrics.ElementsAs(ctx, &fabrics, false)...)
+		sort.Strings(fabrics)
+		setPayloadValue(diags, payload, fabrics, "/fabrics")
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+func getAndSetSecurityDomainAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *SecurityDomainResourceModel) {
+	apiPath := client.GetPath(ctx, diags, nd.FeatureSecurityDomains)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "GET", nil)
+	*data = *getBaseSecurityDomainResourceModel()
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() != nil {
+		securityDomainData := getSecurityDomainDataModel(ctx, diags, responseData)
+		data.Id = securityDomainData.Id
+		data.Name = securityDomainData.Name
+		data.Description = securityDomainData.Description
+		data.Restricted = securityDomainData.Restricted
+		data.Fabrics = securityDomainData.Fabrics
+	} else {
+		data.Id = basetypes.NewStringNull()
+	}
+}
+
+// getSecurityDomainDataModel returns the attributes of the security domain returned by ND, the attributes that are not
+// returned are set to their defaults so changes made outside of Terraform are detected.
+func getSecurityDomainDataModel(ctx context.Context, diags *diag.Diagnostics, responseData *gabs.Container) SecurityDomainDataModel {
+	data := SecurityDomainDataModel{
+		Id:          basetypes.NewStringNull(),
+		Name:        basetypes.NewStringNull(),
+		Description: basetypes.NewStringValue(""),
+		Restricted:  basetypes.NewBoolValue(false),
+		Fabrics:     basetypes.NewSetValueMust(types.StringType, []attr.Value{}),
+	}
+
+	if value, ok := getResponseValue(responseData, "/name").(string); ok {
+		data.Id = basetypes.NewStringValue(value)
+		data.Name = basetypes.NewStringValue(value)
+	}
+	if value, ok := getResponseValue(responseData, "/description").(string); ok {
+		data.Description = basetypes.NewStringValue(value)
+	}
+	if value, ok := getResponseValue(responseData, "/restricted").(bool); ok {
+		data.Restricted = basetypes.NewBoolValue(value)
+	}
+
+	fabrics := getResponseNames(getResponseValue(responseData, "/fabrics"))
+	sort.Strings(fabrics)
+	fabricsSet, setDiags := types.SetValueFrom(ctx, types.StringType, fabrics)
+	diags.Append(setDiags...)
+	data.Fabrics = fabricsSet
+	return data
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccResourceNdSecurityDomain(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdSecurityDomainCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_security_domain.test", "id", "terraform_domain"),
+					resource.TestCheckResourceAttr("nd_security_domain.test", "name", "terraform_domain"),
+					resource.TestCheckResourceAttr("nd_security_domain.test", "description", ""),
+					resource.TestCheckResourceAttr("nd_security_domain.test", "restricted", "false"),
+					resource.TestCheckResourceAttr("nd_security_domain.test", "fabrics.#", "0"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_security_domain.test",
+				ImportState:       true,
+				ImportStateId:     "terraform_domain",
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdSecurityDomainUpdate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_security_domain.test", "id", "terraform_domain"),
+					resource.TestCheckResourceAttr("nd_security_domain.test", "name", "terraform_domain"),
+					resource.TestCheckResourceAttr("nd_security_domain.test", "description", "Created by Terraform"),
+					resource.TestCheckResourceAttr("nd_security_domain.test", "restricted", "true"),
+					resource.TestCheckResourceAttr("nd_security_domain.test", "fabrics.#", "1"),
+					resource.TestCheckTypeSetElemAttr("nd_security_domain.test", "fabrics.*", "nd1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNdSecurityDomainImportIdentity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testConfigResourceNdSecurityDomainUpdate,
+			},
+			{
+				ResourceName:    "nd_security_domain.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestSecurityDomainAttributes(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/aaa/securityDomains/domain1":
+			fmt.Fprint(w, `{"name": "domain1", "restricted": true, "fabrics": ["nd2", {"name": "nd1"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var diags diag.Diagnostics
+	data := getBaseSecurityDomainResourceModel()
+	data.Id = basetypes.NewStringValue("domain1")
+	data.Description = basetypes.NewStringValue("Changed outside of Terraform")
+	getAndSetSecurityDomainAttributes(context.Background(), &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Id.ValueString() != "domain1" || data.Name.ValueString() != "domain1" {
+		t.Errorf("unexpected id %s and name %s", data.Id, data.Name)
+	}
+	if data.Description.ValueString() != "" {
+		t.Errorf("expected the description removed outside of Terraform to be empty, got %s", data.Description)
+	}
+	if !data.Restricted.ValueBool() {
+		t.Errorf("expected restricted to be true")
+	}
+	var fabrics []string
+	diags.Append(data.Fabrics.ElementsAs(context.Background(), &fabrics, false)...)
+	if len(fabrics) != 2 || fabrics[0] != "nd1" || fabrics[1] != "nd2" {
+		t.Errorf("expected fabrics [nd1 nd2], got %v", fabrics)
+	}
+
+	data.Id = basetypes.NewStringValue("domain2")
+	getAndSetSecurityDomainAttributes(context.Background(), &diags, client, data)
+	if diags.HasError() || !data.Id.IsNull() {
+		t.Errorf("expected a null id for a deleted security domain, got %s: %v", data.Id, diags)
+	}
+}
+
+const testConfigResourceNdSecurityDomainCreate = `
+resource "nd_security_domain" "test" {
+  name = "terraform_domain"
+}
+`
+
+const testConfigResourceNdSecurityDomainUpdate = `
+resource "nd_security_domain" "test" {
+  name        = "terraform_domain"
+  description = "Created by Terraform"
+  restricted  = true
+  fabrics     = ["nd1"]
+}
+`
@@ -0,0 +1,412 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultServiceTimeout = 1 * time.Hour
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServiceResource{}
+var _ resource.ResourceWithImportState = &ServiceResource{}
+var _ resource.ResourceWithValidateConfig = &ServiceResource{}
+
+func NewServiceResource() resource.Resource {
+	return &ServiceResource{}
+}
+
+// ServiceResource defines the resource implementation.
+type ServiceResource struct {
+	client *client.Client
+}
+
+// ServiceResourceModel describes the resource data model.
+type ServiceResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Version    types.String `tfsdk:"version"`
+	SourcePath types.String `tfsdk:"source_path"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Profile    types.String `tfsdk:"profile"`
+	Timeout    types.String `tfsdk:"timeout"`
+	State      types.String `tfsdk:"state"`
+	Health     types.String `tfsdk:"health"`
+}
+
+func getBaseServiceResourceModel(sourcePath, timeout basetypes.StringValue) *ServiceResourceModel {
+	return &ServiceResourceModel{
+		Id:         basetypes.NewStringNull(),
+		Name:       basetypes.NewStringNull(),
+		Version:    basetypes.NewStringNull(),
+		SourcePath: sourcePath,
+		Enabled:    basetypes.NewBoolNull(),
+		Profile:    basetypes.NewStringNull(),
+		Timeout:    timeout,
+		State:      basetypes.NewStringNull(),
+		Health:     basetypes.NewStringNull(),
+	}
+}
+
+func (r *ServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_service")
+	resp.TypeName = req.ProviderTypeName + "_service"
+	tflog.Debug(ctx, "End metadata of resource: nd_service")
+}
+
+func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_service")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Services installed on Nexus Dashboard, ie Orchestrator, Insights and Fabric Controller. The service is upgraded when the version changes.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the service.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the service, ie 'orchestrator', 'insights' or 'fabric-controller'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The version of the service. The service is upgraded when the version changes.",
+			},
+			"source_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The local path of the image file of the service version. The image is uploaded to ND when the service is installed or upgraded, the version is installed from the catalog of ND when the source path is not provided.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Enables the service. Defaults to true.",
+				Default:             booldefault.StaticBool(true),
+			},
+			"profile": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The deployment profile of the service which sets its scale, ie 'small' or 'large'. ND selects the default profile of the service when it is not provided.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum duration of the installation, upgrade or update of the service until the service is healthy, ie '2h'. Defaults to '1h'.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The state of the service, ie 'enabled' or 'disabled'.",
+			},
+			"health": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The health of the enabled service, ie 'healthy' or 'degraded'.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_service")
+}
+
+func (r *ServiceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData *ServiceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() || configData.Timeout.IsUnknown() {
+		return
+	}
+
+	getTimeout(&resp.Diagnostics, configData.Timeout, defaultServiceTimeout)
+}
+
+func (r *ServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_service")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_service")
+}
+
+func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_service")
+
+	var planData *ServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !deployService(ctx, &resp.Diagnostics, r.client, planData, nil) {
+		return
+	}
+
+	// The service is saved in the state once the installation started, a service which did not become healthy is
+	// tainted and replaced at the next apply.
+	planData.Id = planData.Name
+	getAndSetServiceAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_service with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_service")
+	var stateData *ServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_service with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetServiceAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *ServiceResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_service with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_service")
+
+	var planData *ServiceResourceModel
+	var stateData *ServiceResourceModel
+
+	// Read Terraform plan data and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_service with id '%s'", planData.Id.ValueString()))
+
+	// The service is only updated when its version, profile or enabled state changes, ie not when only the timeout changes.
+	if !planData.Version.Equal(stateData.Version) || !planData.Profile.Equal(stateData.Profile) || !planData.Enabled.Equal(stateData.Enabled) {
+		if !deployService(ctx, &resp.Diagnostics, r.client, planData, stateData) {
+			return
+		}
+	}
+
+	getAndSetServiceAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_service")
+}
+
+func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_service")
+	var stateData *ServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	timeout := getTimeout(&resp.Diagnostics, stateData.Timeout, defaultServiceTimeout)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_service with id '%s'", stateData.Id.ValueString()))
+	task, err := r.client.Services.Uninstall(ctx, stateData.Id.ValueString())
+	if nd.IsNotFound(err) {
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to uninstall the service '%s'", stateData.Id.ValueString()),
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+	if task.Id != "" {
+		waitForTask(ctx, &resp.Diagnostics, r.client, task, fmt.Sprintf("Uninstallation of the service '%s'", stateData.Id.ValueString()), nil)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_service with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *ServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_service")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_service with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_service")
+}
+
+// deployService installs the service, or updates the installed service when the state data is provided, and waits
+// until the service is enabled and healthy or disabled. The image of the source path is uploaded before the service
+// is installed or upgraded. It returns whether the installation or update was started.
+func deployService(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data, stateData *ServiceResourceModel) bool {
+	timeout := getTimeout(diags, data.Timeout, defaultServiceTimeout)
+	if diags.HasError() {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	name, version := data.Name.ValueString(), data.Version.ValueString()
+	enabled := data.Enabled.IsNull() || data.Enabled.ValueBool()
+	service := &nd.Service{Name: name, Version: version, Enabled: &enabled}
+	if !data.Profile.IsUnknown() {
+		service.Profile = data.Profile.ValueString()
+	}
+
+	// The image is only uploaded when the version is installed, ie not when only the profile of the service changes.
+	if !data.SourcePath.IsNull() && (stateData == nil || !data.Version.Equal(stateData.Version)) {
+		service.Image = uploadServiceImage(ctx, diags, client, data)
+		if diags.HasError() {
+			return false
+		}
+	}
+
+	var task *nd.Task
+	var err error
+	description := fmt.Sprintf("Installation of the service '%s' %s", name, version)
+	if stateData == nil {
+		task, err = client.Services.Install(ctx, service)
+	} else {
+		if !data.Version.Equal(stateData.Version) {
+			description = fmt.Sprintf("Upgrade of the service '%s' from %s to %s", name, stateData.Version.ValueString(), version)
+		} else {
+			description = fmt.Sprintf("Update of the service '%s'", name)
+		}
+		task, err = client.Services.Update(ctx, service)
+	}
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("%s failed to start", description),
+			fmt.Sprintf("Error: %s", err),
+		)
+		return false
+	}
+
+	waitForService(ctx, diags, client, task, name, description)
+	return true
+}
+
+// uploadServiceImage uploads the image of the source path and returns the name of the image, an error is added to the
+// diagnostics when the image does not contain the version of the service.
+func uploadServiceImage(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *ServiceResourceModel) string {
+	sourcePath := data.SourcePath.ValueString()
+	source, err := nd.NewFileSource(sourcePath)
+	if err == nil {
+		var image *nd.ServiceImage
+		image, err = client.Services.UploadImage(ctx, source, getTransferProgress(ctx, fmt.Sprintf("Upload of the service image '%s'", sourcePath), nil))
+		if err == nil {
+			if (image.Service != "" && image.Service != data.Name.ValueString()) || (image.Version != "" && image.Version != data.Version.ValueString()) {
+				diags.AddAttributeError(
+					path.Root("source_path"),
+					"Invalid service image",
+					fmt.Sprintf("The image '%s' contains the service '%s' %s, expected the service '%s' %s.", sourcePath, image.Service, image.Version, data.Name.ValueString(), data.Version.ValueString()),
+				)
+				return ""
+			}
+			return image.Name
+		}
+	}
+	diags.AddError(
+		fmt.Sprintf("Unable to upload the service image '%s'", sourcePath),
+		fmt.Sprintf("Error: %s", err),
+	)
+	return ""
+}
+
+// waitForService waits until the ND task of the service is done and the service is enabled and healthy or disabled.
+func waitForService(ctx context.Context, diags *diag.Diagnostics, client *client.Client, task *nd.Task, name, description string) {
+	if task.Id != "" {
+		waitForTask(ctx, diags, client, task, description, nil)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	_, err := client.Services.Wait(ctx, name, func(service *nd.Service) {
+		tflog.Info(ctx, fmt.Sprintf("%s: %s", description, service))
+	})
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("%s failed", description),
+			fmt.Sprintf("Error: %s", err),
+		)
+	}
+}
+
+func getAndSetServiceAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *ServiceResourceModel) {
+	service, err := client.Services.Get(ctx, data.Id.ValueString())
+	// The API does not return the source of the image, the source path and timeout are assigned based on the user's configuration settings.
+	*data = *getBaseServiceResourceModel(data.SourcePath, data.Timeout)
+	if nd.IsNotFound(err) {
+		return
+	} else if err != nil {
+		diags.AddError(
+			"Unable to read the service",
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(service.Name)
+	data.Name = basetypes.NewStringValue(service.Name)
+	data.Version = basetypes.NewStringValue(service.Version)
+	if service.Enabled != nil {
+		data.Enabled = basetypes.NewBoolValue(*service.Enabled)
+	} else {
+		data.Enabled = basetypes.NewBoolValue(service.State != nd.ServiceStateDisabled)
+	}
+	data.Profile = basetypes.NewStringValue(service.Profile)
+	data.State = basetypes.NewStringValue(service.State)
+	if service.Health != "" {
+		data.Health = basetypes.NewStringValue(service.Health)
+	}
+}
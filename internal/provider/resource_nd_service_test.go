@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// testServiceServer installs and updates a service, the service becomes healthy after a poll in the installing state.
+type testServiceServer struct {
+	service  *nd.Service
+	sent     []nd.Service
+	uploaded string
+	failure  string
+	polls    int
+}
+
+func (s *testServiceServer) handle(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == "POST" && r.URL.Path == "/api/v1/infra/services/images":
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		io.Copy(io.Discard, file)
+		s.uploaded = header.Filename
+		io.WriteString(w, `{"name": "insights-6.5.2.nd", "service": "insights", "version": "6.5.2"}`)
+	case (r.Method == "POST" && r.URL.Path == "/api/v1/infra/services") || (r.Method == "PUT" && r.URL.Path == "/api/v1/infra/services/insights"):
+		service := nd.Service{}
+		json.NewDecoder(r.Body).Decode(&service)
+		s.sent = append(s.sent, service)
+		if service.Profile == "" {
+			service.Profile = "small"
+		}
+		service.Image = ""
+		service.State, s.polls = nd.ServiceStateInstalling, 0
+		s.service = &service
+		w.WriteHeader(http.StatusAccepted)
+	case r.Method == "GET" && r.URL.Path == "/api/v1/infra/services/insights" && s.service != nil:
+		s.polls++
+		if s.polls > 1 && s.service.State == nd.ServiceStateInstalling {
+			switch {
+			case s.failure != "":
+				s.service.State, s.service.Message = nd.ServiceStateFailed, s.failure
+			case *s.service.Enabled:
+				s.service.State, s.service.Health = nd.ServiceStateEnabled, nd.ServiceHealthHealthy
+			default:
+				s.service.State = nd.ServiceStateDisabled
+			}
+		}
+		json.NewEncoder(w).Encode(s.service)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestService(t *testing.T) {
+	server := &testServiceServer{}
+	client := newTestProviderClient(t, server.handle)
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseServiceResourceModel(basetypes.NewStringNull(), basetypes.NewStringNull())
+	data.Name = basetypes.NewStringValue("insights")
+	data.Version = basetypes.NewStringValue("6.5.1")
+	data.Enabled = basetypes.NewBoolValue(true)
+	data.Profile = basetypes.NewStringUnknown()
+	if !deployService(ctx, &diags, client, data, nil) || diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if sent := server.sent[0]; sent.Name != "insights" || sent.Version != "6.5.1" || sent.Image != "" || sent.Profile != "" || !*sent.Enabled {
+		t.Errorf("unexpected installation payload %+v", sent)
+	}
+
+	data.Id = basetypes.NewStringValue("insights")
+	getAndSetServiceAttributes(ctx, &diags, client, data)
+	if data.Version.ValueString() != "6.5.1" || data.Profile.ValueString() != "small" || data.State.ValueString() != "enabled" || data.Health.ValueString() != "healthy" || !data.Enabled.ValueBool() {
+		t.Errorf("unexpected version %s, profile %s, state %s and health %s", data.Version, data.Profile, data.State, data.Health)
+	}
+
+	// The image of the source path is uploaded when the service is upgraded.
+	sourcePath := filepath.Join(t.TempDir(), "insights-6.5.2.nd")
+	if err := os.WriteFile(sourcePath, []byte("image"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	stateData := *data
+	data.Version = basetypes.NewStringValue("6.5.2")
+	data.SourcePath = basetypes.NewStringValue(sourcePath)
+	if !deployService(ctx, &diags, client, data, &stateData) || diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if sent := server.sent[1]; sent.Version != "6.5.2" || sent.Image != "insights-6.5.2.nd" || sent.Profile != "small" || server.uploaded != "insights-6.5.2.nd" {
+		t.Errorf("unexpected upgrade payload %+v", sent)
+	}
+
+	// The image is not uploaded again when only the service is disabled.
+	server.uploaded = ""
+	stateData = *data
+	data.Enabled = basetypes.NewBoolValue(false)
+	if !deployService(ctx, &diags, client, data, &stateData) || diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	getAndSetServiceAttributes(ctx, &diags, client, data)
+	if server.uploaded != "" || server.sent[2].Image != "" || data.State.ValueString() != "disabled" || !data.Health.IsNull() || data.Enabled.ValueBool() {
+		t.Errorf("unexpected upload %s, state %s, health %s and enabled %s", server.uploaded, data.State, data.Health, data.Enabled)
+	}
+}
+
+func TestServiceFailed(t *testing.T) {
+	server := &testServiceServer{failure: "Not enough resources"}
+	client := newTestProviderClient(t, server.handle)
+
+	var diags diag.Diagnostics
+	data := getBaseServiceResourceModel(basetypes.NewStringNull(), basetypes.NewStringNull())
+	data.Name = basetypes.NewStringValue("insights")
+	data.Version = basetypes.NewStringValue("6.5.1")
+	if !deployService(context.Background(), &diags, client, data, nil) {
+		t.Fatal("expected the installation to start")
+	}
+	if !diags.HasError() || diags.Errors()[0].Detail() != "Error: the service insights 6.5.1 failed: Not enough resources" {
+		t.Errorf("expected an error for the failed service, got %v", diags)
+	}
+
+	// The image must contain the version of the service.
+	sourcePath := filepath.Join(t.TempDir(), "insights.nd")
+	if err := os.WriteFile(sourcePath, []byte("image"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	diags = nil
+	data.SourcePath = basetypes.NewStringValue(sourcePath)
+	if deployService(context.Background(), &diags, client, data, nil) || !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "expected the service 'insights' 6.5.1") {
+		t.Errorf("expected an invalid image error, got %v", diags)
+	}
+}
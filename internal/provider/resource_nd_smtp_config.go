@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SmtpConfigResource{}
+var _ resource.ResourceWithImportState = &SmtpConfigResource{}
+var _ resource.ResourceWithValidateConfig = &SmtpConfigResource{}
+
+// smtpConfigId is the ID of the nd_smtp_config resource, the SMTP configuration is a single object of the cluster.
+const smtpConfigId = "smtp"
+
+// smtpTlsModes maps the TLS modes of the resource to the TLS modes of ND.
+var smtpTlsModes = map[string]string{
+	"none":     "none",
+	"starttls": "startTLS",
+	"tls":      "tls",
+}
+
+// emailAddressRegex is a loose check of an email address, the address is validated by the SMTP server.
+var emailAddressRegex = regexp.MustCompile(`^[^@\s]+@[^@\s]+$`)
+
+func NewSmtpConfigResource() resource.Resource {
+	return &SmtpConfigResource{}
+}
+
+// SmtpConfigResource defines the resource implementation.
+type SmtpConfigResource struct {
+	client *client.Client
+}
+
+// SmtpConfigResourceModel describes the resource data model.
+type SmtpConfigResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Server         types.String `tfsdk:"server"`
+	Port           types.Int64  `tfsdk:"port"`
+	TlsMode        types.String `tfsdk:"tls_mode"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	FromAddress    types.String `tfsdk:"from_address"`
+	SecretsVersion types.Int64  `tfsdk:"secrets_version"`
+}
+
+func getBaseSmtpConfigResourceModel(secretsVersion basetypes.Int64Value) *SmtpConfigResourceModel {
+	return &SmtpConfigResourceModel{
+		Id:             basetypes.NewStringNull(),
+		Server:         basetypes.NewStringNull(),
+		Port:           basetypes.NewInt64Null(),
+		TlsMode:        basetypes.NewStringNull(),
+		Username:       basetypes.NewStringNull(),
+		Password:       basetypes.NewStringNull(),
+		FromAddress:    basetypes.NewStringNull(),
+		SecretsVersion: secretsVersion,
+	}
+}
+
+func (r *SmtpConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_smtp_config")
+	resp.TypeName = req.ProviderTypeName + "_smtp_config"
+	tflog.Debug(ctx, "End metadata of resource: nd_smtp_config")
+}
+
+func (r *SmtpConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_smtp_config")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the SMTP configuration of the email notifications of the Nexus Dashboard cluster. The SMTP configuration captured at creation is restored when the resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the SMTP configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The hostname or IP address of the SMTP server.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(25),
+				MarkdownDescription: "The port of the SMTP server, SMTP servers usually listen on port 587 for the `starttls` mode and on port 465 for the `tls` mode.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"tls_mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("starttls"),
+				MarkdownDescription: "The encryption of the connection to the SMTP server, 'starttls' upgrades the plain connection to TLS and 'tls' connects with TLS.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "starttls", "tls"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The username used to authenticate to the SMTP server, the emails are sent without authentication when the username is not configured.",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The password used to authenticate to the SMTP server, required when the `username` is configured. The password is write-only and never stored in the state, it is sent to ND when the resource is created and when the `secrets_version` changes.",
+			},
+			"from_address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email address of the sender of the emails sent by ND.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emailAddressRegex, "must be an email address"),
+				},
+			},
+			"secrets_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of the write-only secrets, the secrets are sent to ND when the version changes.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_smtp_config")
+}
+
+func (r *SmtpConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData *SmtpConfigResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() || configData.Username.IsUnknown() || configData.Password.IsUnknown() {
+		return
+	}
+
+	if !configData.Username.IsNull() && configData.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing password",
+			"The 'password' attribute is required when the 'username' is configured.",
+		)
+	} else if configData.Username.IsNull() && !configData.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Invalid password",
+			"The 'password' attribute is invalid when the 'username' is not configured.",
+		)
+	}
+}
+
+func (r *SmtpConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_smtp_config")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_smtp_config")
+}
+
+func (r *SmtpConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_smtp_config")
+
+	var planData *SmtpConfigResourceModel
+	var configData *SmtpConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getSmtpConfigJsonPayload(&resp.Diagnostics, planData, configData)
+	comparePayload := getSmtpConfigJsonPayload(&resp.Diagnostics, planData, nil)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The current configuration is captured before it is changed, it is restored when the resource is destroyed.
+	// The password is not returned by ND, the password is always sent when it is configured.
+	currentData := putClusterConfig(ctx, &resp.Diagnostics, r.client, nd.FeatureSMTPConfig, jsonPayload, comparePayload, !configData.Password.IsNull())
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if currentData.Data() != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, previousConfigKey, currentData.Bytes())...)
+	}
+
+	planData.Id = basetypes.NewStringValue(smtpConfigId)
+	getAndSetSmtpConfigAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_smtp_config with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *SmtpConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_smtp_config")
+	var stateData *SmtpConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_smtp_config with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetSmtpConfigAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *SmtpConfigResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_smtp_config with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *SmtpConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_smtp_config")
+
+	var planData *SmtpConfigResourceModel
+	var stateData *SmtpConfigResourceModel
+	var configData *SmtpConfigResourceModel
+
+	// Read Terraform plan data and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	// Write only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_smtp_config with id '%s'", planData.Id.ValueString()))
+
+	// The secrets are only sent when the secrets version changes, ND keeps the current password when it is not provided.
+	secretsData := configData
+	if planData.SecretsVersion.Equal(stateData.SecretsVersion) {
+		secretsData = nil
+	}
+
+	jsonPayload := getSmtpConfigJsonPayload(&resp.Diagnostics, planData, secretsData)
+	comparePayload := getSmtpConfigJsonPayload(&resp.Diagnostics, planData, nil)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	putClusterConfig(ctx, &resp.Diagnostics, r.client, nd.FeatureSMTPConfig, jsonPayload, comparePayload, secretsData != nil && !secretsData.Password.IsNull())
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetSmtpConfigAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_smtp_config")
+}
+
+func (r *SmtpConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_smtp_config")
+	var stateData *SmtpConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_smtp_config with id '%s'", stateData.Id.ValueString()))
+
+	previousConfig, diags := req.Private.GetKey(ctx, previousConfigKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restoreClusterConfig(ctx, &resp.Diagnostics, r.client, nd.FeatureSMTPConfig, previousConfig)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_smtp_config with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *SmtpConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_smtp_config")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_smtp_config with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_smtp_config")
+}
+
+// getSmtpConfigJsonPayload returns the payload of the SMTP configuration, the write-only password is read from the
+// configuration and is omitted when the configuration is nil.
+func getSmtpConfigJsonPayload(diags *diag.Diagnostics, data, configData *SmtpConfigResourceModel) *gabs.Container {
+	payload := gabs.New()
+
+	setPayloadValue(diags, payload, data.Server.ValueString(), "/server")
+	setPayloadValue(diags, payload, data.Port.ValueInt64(), "/port")
+	setPayloadValue(diags, payload, smtpTlsModes[data.TlsMode.ValueString()], "/tlsMode")
+	setPayloadValue(diags, payload, data.Username.ValueString(), "/username")
+	setPayloadValue(diags, payload, data.FromAddress.ValueString(), "/fromAddress")
+
+	if configData != nil && !configData.Password.IsNull() && !configData.Password.IsUnknown() {
+		setPayloadValue(diags, payload, configData.Password.ValueString(), "/password")
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+func getAndSetSmtpConfigAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *SmtpConfigResourceModel) {
+	responseData := getClusterConfig(ctx, diags, client, nd.FeatureSMTPConfig)
+	// The API does not return the password, the secrets_version is assigned based on the user's configuration settings.
+	*data = *getBaseSmtpConfigResourceModel(data.SecretsVersion)
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		return
+	}
+
+	data.Id = basetypes.NewStringValue(smtpConfigId)
+	data.Server = basetypes.NewStringValue(getResponseString(responseData, "/server"))
+	data.Port = basetypes.NewInt64Value(getResponseInt64(responseData, "/port"))
+	for tlsMode, value := range smtpTlsModes {
+		if value == getResponseString(responseData, "/tlsMode") {
+			data.TlsMode = basetypes.NewStringValue(tlsMode)
+		}
+	}
+	// ND returns an empty username when the emails are sent without authentication.
+	if username := getResponseString(responseData, "/username"); username != "" {
+		data.Username = basetypes.NewStringValue(username)
+	}
+	data.FromAddress = basetypes.NewStringValue(getResponseString(responseData, "/fromAddress"))
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceNdSmtpConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdSmtpConfigCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_smtp_config.test", "id", "smtp"),
+					resource.TestCheckResourceAttr("nd_smtp_config.test", "server", "smtp.example.com"),
+					resource.TestCheckResourceAttr("nd_smtp_config.test", "port", "25"),
+					resource.TestCheckResourceAttr("nd_smtp_config.test", "tls_mode", "starttls"),
+					resource.TestCheckResourceAttr("nd_smtp_config.test", "from_address", "nd@example.com"),
+					resource.TestCheckNoResourceAttr("nd_smtp_config.test", "username"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_smtp_config.test",
+				ImportState:       true,
+				ImportStateId:     "smtp",
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdSmtpConfigUpdate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_smtp_config.test", "port", "465"),
+					resource.TestCheckResourceAttr("nd_smtp_config.test", "tls_mode", "tls"),
+					resource.TestCheckResourceAttr("nd_smtp_config.test", "username", "nd"),
+					resource.TestCheckResourceAttr("nd_smtp_config.test", "secrets_version", "1"),
+					resource.TestCheckNoResourceAttr("nd_smtp_config.test", "password"),
+				),
+			},
+		},
+	})
+}
+
+// Validate Credentials Errors
+func TestAccResourceNdSmtpConfigError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfigResourceNdSmtpConfigMissingPasswordError,
+				ExpectError: regexp.MustCompile("The 'password' attribute is required when the 'username' is configured"),
+			},
+		},
+	})
+}
+
+func TestSmtpConfigReadComparePut(t *testing.T) {
+	current := `{"server": "smtp.example.com", "port": 587, "tlsMode": "startTLS", "username": "nd", "fromAddress": "nd@example.com"}`
+	puts := []string{}
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/infra/clusterConfig/smtp" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == "PUT" {
+			body, _ := io.ReadAll(r.Body)
+			puts = append(puts, string(body))
+		}
+		io.WriteString(w, current)
+	})
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseSmtpConfigResourceModel(basetypes.NewInt64Value(1))
+	getAndSetSmtpConfigAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Id.ValueString() != "smtp" || data.Port.ValueInt64() != 587 || data.TlsMode.ValueString() != "starttls" || data.Username.ValueString() != "nd" {
+		t.Errorf("unexpected id %s, port %s, TLS mode %s and username %s", data.Id, data.Port, data.TlsMode, data.Username)
+	}
+	if data.SecretsVersion.ValueInt64() != 1 || !data.Password.IsNull() {
+		t.Errorf("expected the secrets version to be kept and the password to be null, got %s and %s", data.SecretsVersion, data.Password)
+	}
+
+	// The configuration is not sent when it matches the current configuration and the password is not changed.
+	comparePayload := getSmtpConfigJsonPayload(&diags, data, nil)
+	putClusterConfig(ctx, &diags, client, nd.FeatureSMTPConfig, comparePayload, comparePayload, false)
+	if diags.HasError() || len(puts) != 0 {
+		t.Fatalf("expected no configuration to be sent, got %v: %v", puts, diags)
+	}
+
+	// The password is only sent when the configuration is provided.
+	configData := getBaseSmtpConfigResourceModel(basetypes.NewInt64Value(2))
+	configData.Password = basetypes.NewStringValue("smtp_password")
+	jsonPayload := getSmtpConfigJsonPayload(&diags, data, configData)
+	putClusterConfig(ctx, &diags, client, nd.FeatureSMTPConfig, jsonPayload, comparePayload, true)
+	expected := `{"fromAddress":"nd@example.com","password":"smtp_password","port":587,"server":"smtp.example.com","tlsMode":"startTLS","username":"nd"}`
+	if diags.HasError() || len(puts) != 1 || puts[0] != expected {
+		t.Errorf("unexpected configuration sent %v, expected %s: %v", puts, expected, diags)
+	}
+
+	// ND returns an empty username when the emails are sent without authentication.
+	current = `{"server": "smtp.example.com", "port": 25, "tlsMode": "none", "username": "", "fromAddress": "nd@example.com"}`
+	getAndSetSmtpConfigAttributes(ctx, &diags, client, data)
+	if diags.HasError() || data.TlsMode.ValueString() != "none" || !data.Username.IsNull() {
+		t.Errorf("unexpected TLS mode %s and username %s: %v", data.TlsMode, data.Username, diags)
+	}
+}
+
+const testConfigResourceNdSmtpConfigCreate = `
+resource "nd_smtp_config" "test" {
+  server       = "smtp.example.com"
+  from_address = "nd@example.com"
+}
+`
+
+const testConfigResourceNdSmtpConfigUpdate = `
+resource "nd_smtp_config" "test" {
+  server          = "smtp.example.com"
+  port            = 465
+  tls_mode        = "tls"
+  username        = "nd"
+  password        = "terraform_password"
+  from_address    = "nd@example.com"
+  secrets_version = 1
+}
+`
+
+const testConfigResourceNdSmtpConfigMissingPasswordError = `
+resource "nd_smtp_config" "test" {
+  server       = "smtp.example.com"
+  username     = "nd"
+  from_address = "nd@example.com"
+}
+`
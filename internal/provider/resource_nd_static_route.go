@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StaticRouteResource{}
+var _ resource.ResourceWithImportState = &StaticRouteResource{}
+var _ resource.ResourceWithValidateConfig = &StaticRouteResource{}
+
+// staticRouteNetworkPointers are the JSON pointers of the static routes of each network type in the cluster network configuration.
+var staticRouteNetworkPointers = map[string]string{
+	"management": "/managementNetwork/staticRoutes",
+	"data":       "/dataNetwork/staticRoutes",
+}
+
+func NewStaticRouteResource() resource.Resource {
+	return &StaticRouteResource{}
+}
+
+// StaticRouteResource defines the resource implementation.
+type StaticRouteResource struct {
+	client *client.Client
+}
+
+// StaticRouteResourceModel describes the resource data model.
+type StaticRouteResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	NetworkType types.String `tfsdk:"network_type"`
+	Prefix      types.String `tfsdk:"prefix"`
+}
+
+func getBaseStaticRouteResourceModel() *StaticRouteResourceModel {
+	return &StaticRouteResourceModel{
+		Id:          basetypes.NewStringNull(),
+		NetworkType: basetypes.NewStringNull(),
+		Prefix:      basetypes.NewStringNull(),
+	}
+}
+
+func (r *StaticRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_static_route")
+	resp.TypeName = req.ProviderTypeName + "_static_route"
+	tflog.Debug(ctx, "End metadata of resource: nd_static_route")
+}
+
+func (r *StaticRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_static_route")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages Static Routes of the management and data networks of the Nexus Dashboard cluster. The static routes which are not managed by the resource are preserved, an existing static route must be imported.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the static route in the format `{network_type}:{prefix}`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The network of the cluster of the static route.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("management", "data"),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IPv4 or IPv6 destination prefix of the static route, ie `10.0.0.0/8` or `2001:db8::/32`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_static_route")
+}
+
+func (r *StaticRouteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData *StaticRouteResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() || configData.Prefix.IsNull() || configData.Prefix.IsUnknown() {
+		return
+	}
+
+	if err := validateStaticRoutePrefix(configData.Prefix.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("prefix"),
+			"Invalid static route prefix",
+			fmt.Sprintf("The prefix '%s' %s.", configData.Prefix.ValueString(), err),
+		)
+	}
+}
+
+func (r *StaticRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_static_route")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_static_route")
+}
+
+func (r *StaticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_static_route")
+
+	var planData *StaticRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix := planData.Prefix.ValueString()
+	addStaticRoute(ctx, &resp.Diagnostics, r.client, planData.NetworkType.ValueString(), prefix)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData.Id = basetypes.NewStringValue(getStaticRouteId(planData.NetworkType.ValueString(), prefix))
+	getAndSetStaticRouteAttributes(ctx, &resp.Diagnostics, r.client, planData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_static_route with id '%s'", planData.Id.ValueString()))
+}
+
+func (r *StaticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_static_route")
+	var stateData *StaticRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_static_route with id '%s'", stateData.Id.ValueString()))
+
+	getAndSetStaticRouteAttributes(ctx, &resp.Diagnostics, r.client, stateData)
+
+	// Save updated data into Terraform state
+	if stateData.Id.IsNull() {
+		var emptyData *StaticRouteResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_static_route with id '%s'", stateData.Id.ValueString()))
+}
+
+// Update is only called when the plan does not change the configured attributes, all of them require the replacement of the static route.
+func (r *StaticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_static_route")
+
+	var planData *StaticRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+	tflog.Debug(ctx, "End update of resource nd_static_route")
+}
+
+func (r *StaticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_static_route")
+	var stateData *StaticRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_static_route with id '%s'", stateData.Id.ValueString()))
+
+	prefix := stateData.Prefix.ValueString()
+	updateClusterConfigList(ctx, &resp.Diagnostics, r.client, nd.FeatureClusterNetworkConfig, staticRouteNetworkPointers[stateData.NetworkType.ValueString()], func(routes []string) []string {
+		if index := getStaticRouteIndex(routes, prefix); index != -1 {
+			routes = append(routes[:index], routes[index+1:]...)
+		}
+		return routes
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_static_route with id '%s'", stateData.Id.ValueString()))
+}
+
+func (r *StaticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_static_route")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_static_route with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_static_route")
+}
+
+// validateStaticRoutePrefix returns an error when the prefix is not an IPv4 or IPv6 network address with a prefix length.
+func validateStaticRoutePrefix(prefix string) error {
+	ip, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return fmt.Errorf("must be an IPv4 or IPv6 prefix, ie '10.0.0.0/8' or '2001:db8::/32'")
+	}
+	if !ip.Equal(network.IP) {
+		return fmt.Errorf("must be a network address, ie '%s'", network)
+	}
+	return nil
+}
+
+// addStaticRoute adds the prefix to the static routes of the network. An existing static route is not taken over, it
+// must be imported so that a static route managed elsewhere is not removed when the resource is destroyed.
+func addStaticRoute(ctx context.Context, diags *diag.Diagnostics, client *client.Client, networkType, prefix string) {
+	exists := false
+	updateClusterConfigList(ctx, diags, client, nd.FeatureClusterNetworkConfig, staticRouteNetworkPointers[networkType], func(routes []string) []string {
+		if getStaticRouteIndex(routes, prefix) != -1 {
+			exists = true
+			return routes
+		}
+		return append(routes, prefix)
+	})
+
+	if !diags.HasError() && exists {
+		diags.AddError(
+			"Static route already exists",
+			fmt.Sprintf("The static route '%s' already exists in the %s network. Import the static route with the ID '%s' to manage it.", prefix, networkType, getStaticRouteId(networkType, prefix)),
+		)
+	}
+}
+
+func getStaticRouteId(networkType, prefix string) string {
+	return fmt.Sprintf("%s:%s", networkType, prefix)
+}
+
+// getStaticRouteIndex returns the index of the prefix in the static routes or -1 when the prefix is not found, the
+// prefixes are compared as networks to ignore the differences of notation of IPv6 addresses.
+func getStaticRouteIndex(routes []string, prefix string) int {
+	_, network, err := net.ParseCIDR(prefix)
+	for index, route := range routes {
+		if route == prefix {
+			return index
+		}
+		if _, routeNetwork, routeErr := net.ParseCIDR(route); err == nil && routeErr == nil && routeNetwork.String() == network.String() {
+			return index
+		}
+	}
+	return -1
+}
+
+func getAndSetStaticRouteAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *StaticRouteResourceModel) {
+	id := data.Id.ValueString()
+	networkType, prefix, found := strings.Cut(id, ":")
+	*data = *getBaseStaticRouteResourceModel()
+	if _, ok := staticRouteNetworkPointers[networkType]; !found || !ok {
+		diags.AddError(
+			"Invalid static route ID",
+			fmt.Sprintf("The ID '%s' must be in the format '{network_type}:{prefix}' with the network type 'management' or 'data'.", id),
+		)
+		return
+	}
+
+	apiPath := client.GetPath(ctx, diags, nd.FeatureClusterNetworkConfig)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, apiPath, "GET", nil)
+	if diags.HasError() || responseData == nil {
+		return
+	}
+
+	routes := getStringElements(getResponseList(responseData, staticRouteNetworkPointers[networkType]))
+	if index := getStaticRouteIndex(routes, prefix); index != -1 {
+		data.Id = basetypes.NewStringValue(getStaticRouteId(networkType, prefix))
+		data.NetworkType = basetypes.NewStringValue(networkType)
+		data.Prefix = basetypes.NewStringValue(prefix)
+	}
+}
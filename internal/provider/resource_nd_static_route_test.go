@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceNdStaticRoute(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdStaticRouteCreate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_static_route.ipv4", "id", "management:10.100.0.0/16"),
+					resource.TestCheckResourceAttr("nd_static_route.ipv4", "network_type", "management"),
+					resource.TestCheckResourceAttr("nd_static_route.ipv4", "prefix", "10.100.0.0/16"),
+					resource.TestCheckResourceAttr("nd_static_route.ipv6", "id", "management:2001:db8:100::/48"),
+					resource.TestCheckResourceAttr("nd_static_route.ipv6", "prefix", "2001:db8:100::/48"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_static_route.ipv6",
+				ImportState:       true,
+				ImportStateId:     "management:2001:db8:100::/48",
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdStaticRouteUpdate,
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_static_route.ipv4", "id", "data:10.100.0.0/16"),
+					resource.TestCheckResourceAttr("nd_static_route.ipv4", "network_type", "data"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateStaticRoutePrefix(t *testing.T) {
+	tests := []struct {
+		prefix      string
+		expectError bool
+	}{
+		{"10.0.0.0/8", false},
+		{"2001:db8::/32", false},
+		{"10.0.0.1/8", true},
+		{"10.0.0.0", true},
+		{"2001:db8::1/32", true},
+		{"invalid", true},
+	}
+	for _, test := range tests {
+		if err := validateStaticRoutePrefix(test.prefix); (err != nil) != test.expectError {
+			t.Errorf("validateStaticRoutePrefix(%s) error = %v, expected error %t", test.prefix, err, test.expectError)
+		}
+	}
+}
+
+func TestStaticRouteMerge(t *testing.T) {
+	var mutex sync.Mutex
+	config := `{"managementNetwork": {"gateway": "192.168.0.1", "staticRoutes": ["172.16.0.0/12"]}, "dataNetwork": {"staticRoutes": []}}`
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/infra/clusterConfig/network" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		if r.Method == "PUT" {
+			body, _ := io.ReadAll(r.Body)
+			config = string(body)
+		}
+		io.WriteString(w, config)
+	})
+
+	// The routes of concurrent resources are all added, the routes managed elsewhere are preserved.
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	diags := make([]diag.Diagnostics, 10)
+	for i := range diags {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			prefix := fmt.Sprintf("10.%d.0.0/16", i)
+			updateClusterConfigList(ctx, &diags[i], client, nd.FeatureClusterNetworkConfig, staticRouteNetworkPointers["management"], func(routes []string) []string {
+				return append(routes, prefix)
+			})
+		}(i)
+	}
+	wg.Wait()
+	for i := range diags {
+		if diags[i].HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags[i])
+		}
+	}
+
+	configData, err := gabs.ParseJSON([]byte(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	routes := getStringElements(getResponseList(configData, "/managementNetwork/staticRoutes"))
+	if len(routes) != 11 || routes[0] != "172.16.0.0/12" || getResponseString(configData, "/managementNetwork/gateway") != "192.168.0.1" {
+		t.Errorf("unexpected configuration %s", config)
+	}
+
+	var readDiags diag.Diagnostics
+	data := getBaseStaticRouteResourceModel()
+	data.Id = basetypes.NewStringValue("management:10.1.0.0/16")
+	getAndSetStaticRouteAttributes(ctx, &readDiags, client, data)
+	if readDiags.HasError() || data.NetworkType.ValueString() != "management" || data.Prefix.ValueString() != "10.1.0.0/16" {
+		t.Errorf("unexpected network type %s and prefix %s: %v", data.NetworkType, data.Prefix, readDiags)
+	}
+
+	data.Id = basetypes.NewStringValue("data:10.1.0.0/16")
+	getAndSetStaticRouteAttributes(ctx, &readDiags, client, data)
+	if readDiags.HasError() || !data.Id.IsNull() {
+		t.Errorf("expected a null id for a missing static route, got %s: %v", data.Id, readDiags)
+	}
+
+	data.Id = basetypes.NewStringValue("10.1.0.0/16")
+	getAndSetStaticRouteAttributes(ctx, &readDiags, client, data)
+	if !readDiags.HasError() {
+		t.Error("expected an error for an invalid id")
+	}
+
+	// An existing static route is not taken over by a new resource.
+	var createDiags diag.Diagnostics
+	addStaticRoute(ctx, &createDiags, client, "management", "172.16.0.0/12")
+	if !createDiags.HasError() || createDiags.Errors()[0].Summary() != "Static route already exists" {
+		t.Errorf("expected an error for an existing static route, got %v", createDiags)
+	}
+
+	createDiags = diag.Diagnostics{}
+	addStaticRoute(ctx, &createDiags, client, "data", "172.16.0.0/12")
+	configData, _ = gabs.ParseJSON([]byte(config))
+	routes = getStringElements(getResponseList(configData, "/dataNetwork/staticRoutes"))
+	if createDiags.HasError() || len(routes) != 1 || routes[0] != "172.16.0.0/12" {
+		t.Errorf("expected the static route to be added to the data network, got %s: %v", config, createDiags)
+	}
+}
+
+const testConfigResourceNdStaticRouteCreate = `
+resource "nd_static_route" "ipv4" {
+  network_type = "management"
+  prefix       = "10.100.0.0/16"
+}
+
+resource "nd_static_route" "ipv6" {
+  network_type = "management"
+  prefix       = "2001:db8:100::/48"
+}
+`
+
+const testConfigResourceNdStaticRouteUpdate = `
+resource "nd_static_route" "ipv4" {
+  network_type = "data"
+  prefix       = "10.100.0.0/16"
+}
+
+resource "nd_static_route" "ipv6" {
+  network_type = "management"
+  prefix       = "2001:db8:100::/48"
+}
+`
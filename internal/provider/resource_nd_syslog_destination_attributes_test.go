@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestSyslogDestinationAttributes(t *testing.T) {
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/syslogDestinations/tls_syslog":
+			io.WriteString(w, `{"name": "tls_syslog", "host": "syslog.example.com", "port": 6514, "protocol": "tls", "facility": "local3", "severity": "informational", "caCertificate": "-----BEGIN CERTIFICATE-----", "enabled": true}`)
+		case "/api/v1/infra/syslogDestinations/udp_syslog":
+			io.WriteString(w, `{"name": "udp_syslog", "description": "UDP", "host": "192.168.10.160", "port": 514, "protocol": "udp", "facility": "local7", "severity": "warning", "caCertificate": "", "enabled": false}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseSyslogDestinationResourceModel()
+	data.Id = basetypes.NewStringValue("tls_syslog")
+	getAndSetSyslogDestinationAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Port.ValueInt64() != 6514 || data.Protocol.ValueString() != "tls" || data.CaCertificate.ValueString() != "-----BEGIN CERTIFICATE-----" || !data.Enabled.ValueBool() {
+		t.Errorf("unexpected port %s, protocol %s, CA certificate %s and enabled %s", data.Port, data.Protocol, data.CaCertificate, data.Enabled)
+	}
+	if payload := getSyslogDestinationJsonPayload(ctx, &diags, data); payload.Path("caCertificate").Data() != data.CaCertificate.ValueString() || payload.Path("severity").Data() != "informational" {
+		t.Errorf("unexpected payload %s", payload)
+	}
+
+	// The CA certificate is only used by the tls protocol.
+	data.Id = basetypes.NewStringValue("udp_syslog")
+	getAndSetSyslogDestinationAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Description.ValueString() != "UDP" || !data.CaCertificate.IsNull() || data.Enabled.ValueBool() {
+		t.Errorf("unexpected description %s, CA certificate %s and enabled %s", data.Description, data.CaCertificate, data.Enabled)
+	}
+	if payload := getSyslogDestinationJsonPayload(ctx, &diags, data); payload.Exists("caCertificate") || payload.Path("enabled").Data() != false {
+		t.Errorf("unexpected payload %s", payload)
+	}
+
+	data.Id = basetypes.NewStringValue("unknown_syslog")
+	getAndSetSyslogDestinationAttributes(ctx, &diags, client, data)
+	if diags.HasError() || !data.Id.IsNull() {
+		t.Errorf("expected a null id for an unknown syslog destination, got %s: %v", data.Id, diags)
+	}
+}
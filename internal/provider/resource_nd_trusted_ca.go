@@ -0,0 +1,428 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TrustedCaResource{}
+var _ resource.ResourceWithImportState = &TrustedCaResource{}
+var _ resource.ResourceWithValidateConfig = &TrustedCaResource{}
+var _ resource.ResourceWithModifyPlan = &TrustedCaResource{}
+
+// trustedCaUsages maps the usages of the resource to the usages of ND.
+var trustedCaUsages = map[string]string{
+	"clusters":         "clusters",
+	"remote_locations": "remoteLocations",
+	"login_domains":    "loginDomains",
+}
+
+func NewTrustedCaResource() resource.Resource {
+	return &TrustedCaResource{}
+}
+
+// TrustedCaResource defines the resource implementation.
+type TrustedCaResource struct {
+	client *client.Client
+}
+
+// TrustedCaResourceModel describes the resource data model.
+type TrustedCaResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Certificate types.String `tfsdk:"certificate"`
+	Usages      types.Set    `tfsdk:"usages"`
+	Subject     types.String `tfsdk:"subject"`
+	Issuer      types.String `tfsdk:"issuer"`
+	NotBefore   types.String `tfsdk:"not_before"`
+	NotAfter    types.String `tfsdk:"not_after"`
+}
+
+func getBaseTrustedCaResourceModel() *TrustedCaResourceModel {
+	return &TrustedCaResourceModel{
+		Id:          basetypes.NewStringNull(),
+		Name:        basetypes.NewStringNull(),
+		Description: basetypes.NewStringNull(),
+		Certificate: basetypes.NewStringNull(),
+		Usages:      basetypes.NewSetNull(types.StringType),
+		Subject:     basetypes.NewStringNull(),
+		Issuer:      basetypes.NewStringNull(),
+		NotBefore:   basetypes.NewStringNull(),
+		NotAfter:    basetypes.NewStringNull(),
+	}
+}
+
+// setDetails sets the computed attributes of the CA certificate.
+func (data *TrustedCaResourceModel) setDetails(details certificateDetails) {
+	data.Subject = details.Subject
+	data.Issuer = details.Issuer
+	data.NotBefore = details.NotBefore
+	data.NotAfter = details.NotAfter
+}
+
+func (r *TrustedCaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	tflog.Debug(ctx, "Start metadata of resource: nd_trusted_ca")
+	resp.TypeName = req.ProviderTypeName + "_trusted_ca"
+	tflog.Debug(ctx, "End metadata of resource: nd_trusted_ca")
+}
+
+func (r *TrustedCaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Start schema of resource: nd_trusted_ca")
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the CA certificates trusted by Nexus Dashboard to validate the certificates of its peers.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the trusted CA certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the trusted CA certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The description of the trusted CA certificate.",
+			},
+			"certificate": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The PEM encoded CA certificate, a bundle of the root and intermediate CA certificates of a private PKI is accepted.",
+			},
+			"usages": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The peers whose certificates are validated with the CA certificate.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("clusters", "remote_locations", "login_domains")),
+				},
+			},
+			"subject": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The subject of the first CA certificate.",
+			},
+			"issuer": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The issuer of the first CA certificate.",
+			},
+			"not_before": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The start of the validity of the first CA certificate in RFC 3339 format.",
+			},
+			"not_after": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The expiry of the first CA certificate in RFC 3339 format.",
+			},
+		},
+	}
+	tflog.Debug(ctx, "End schema of resource: nd_trusted_ca")
+}
+
+func (r *TrustedCaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData *TrustedCaResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+
+	if resp.Diagnostics.HasError() || configData.Certificate.IsNull() || configData.Certificate.IsUnknown() {
+		return
+	}
+
+	certificates, err := parsePemCertificates(configData.Certificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate"),
+			"Invalid certificate",
+			fmt.Sprintf("The 'certificate' attribute must be a PEM encoded CA certificate: %s.", err),
+		)
+		return
+	}
+
+	// The certificates are not rejected, ND reports the certificates which cannot be used as a trust anchor.
+	for _, certificate := range certificates {
+		if !certificate.BasicConstraintsValid || !certificate.IsCA {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("certificate"),
+				"Not a CA certificate",
+				fmt.Sprintf("The certificate '%s' is not a CA certificate, the certificates signed by it are not trusted by ND.", certificate.Subject),
+			)
+		} else if certificate.NotAfter.Before(time.Now()) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("certificate"),
+				"Expired CA certificate",
+				fmt.Sprintf("The CA certificate '%s' expired on %s, the certificates signed by it are not trusted by ND.", certificate.Subject, certificate.NotAfter.UTC().Format(time.RFC3339)),
+			)
+		}
+	}
+}
+
+// ModifyPlan sets the computed attributes of the CA certificate from the planned certificate, the validity of a
+// replaced CA certificate is shown in the plan.
+func (r *TrustedCaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planData *TrustedCaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() || planData.Certificate.IsUnknown() {
+		return
+	}
+
+	planData.setDetails(getCertificateDetails(ctx, &resp.Diagnostics, planData.Certificate.ValueString()))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &planData)...)
+}
+
+func (r *TrustedCaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Start configure of resource: nd_trusted_ca")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+	tflog.Debug(ctx, "End configure of resource: nd_trusted_ca")
+}
+
+func (r *TrustedCaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Start create of resource: nd_trusted_ca")
+
+	var data *TrustedCaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonPayload := getTrustedCaJsonPayload(ctx, &resp.Diagnostics, data)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureTrustedCAs)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, apiPath, "POST", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(data.Name.ValueString())
+	getAndSetTrustedCaAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, fmt.Sprintf("End create of resource nd_trusted_ca with id '%s'", data.Id.ValueString()))
+}
+
+func (r *TrustedCaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Start read of resource: nd_trusted_ca")
+	var data *TrustedCaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Read of resource nd_trusted_ca with id '%s'", data.Id.ValueString()))
+
+	getAndSetTrustedCaAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save updated data into Terraform state
+	if data.Id.IsNull() {
+		var emptyData *TrustedCaResourceModel
+		resp.Diagnostics.Append(resp.State.Set(ctx, &emptyData)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("End read of resource nd_trusted_ca with id '%s'", data.Id.ValueString()))
+}
+
+func (r *TrustedCaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Start update of resource: nd_trusted_ca")
+
+	var data *TrustedCaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update of resource nd_trusted_ca with id '%s'", data.Id.ValueString()))
+
+	jsonPayload := getTrustedCaJsonPayload(ctx, &resp.Diagnostics, data)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureTrustedCAs)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "PUT", jsonPayload)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getAndSetTrustedCaAttributes(ctx, &resp.Diagnostics, r.client, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	tflog.Debug(ctx, "End update of resource nd_trusted_ca")
+}
+
+func (r *TrustedCaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Start delete of resource: nd_trusted_ca")
+	var data *TrustedCaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delete of resource nd_trusted_ca with id '%s'", data.Id.ValueString()))
+	apiPath := r.client.GetPath(ctx, &resp.Diagnostics, nd.FeatureTrustedCAs)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.DoRestRequest(ctx, &resp.Diagnostics, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "DELETE", nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("End delete of resource nd_trusted_ca with id '%s'", data.Id.ValueString()))
+}
+
+func (r *TrustedCaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Start import state of resource: nd_trusted_ca")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, fmt.Sprintf("Import state of resource nd_trusted_ca with id '%s'", req.ID))
+	tflog.Debug(ctx, "End import of state resource: nd_trusted_ca")
+}
+
+func getTrustedCaJsonPayload(ctx context.Context, diags *diag.Diagnostics, data *TrustedCaResourceModel) *gabs.Container {
+	payload := gabs.New()
+
+	setPayloadValue(diags, payload, data.Name.ValueString(), "/name")
+	setPayloadValue(diags, payload, data.Description.ValueString(), "/description")
+	setPayloadValue(diags, payload, data.Certificate.ValueString(), "/certificate")
+
+	usages := make([]string, 0)
+	diags.Append(data.Usages.ElementsAs(ctx, &usages, false)...)
+	for index, usage := range usages {
+		usages[index] = trustedCaUsages[usage]
+	}
+	setPayloadValue(diags, payload, usages, "/usages")
+
+	if diags.HasError() {
+		return nil
+	}
+	return payload
+}
+
+// getTrustedCaUsages returns the usages of the resource from the usages of ND, the unknown usages are ignored.
+func getTrustedCaUsages(values []string) []string {
+	usages := make([]string, 0)
+	for _, value := range values {
+		for usage, ndUsage := range trustedCaUsages {
+			if value == ndUsage {
+				usages = append(usages, usage)
+			}
+		}
+	}
+	return usages
+}
+
+func getAndSetTrustedCaAttributes(ctx context.Context, diags *diag.Diagnostics, client *client.Client, data *TrustedCaResourceModel) {
+	apiPath := client.GetPath(ctx, diags, nd.FeatureTrustedCAs)
+	if diags.HasError() {
+		return
+	}
+
+	responseData := client.DoRestRequest(ctx, diags, fmt.Sprintf("%s/%s", apiPath, data.Id.ValueString()), "GET", nil)
+	stateCertificate := data.Certificate
+	*data = *getBaseTrustedCaResourceModel()
+	if diags.HasError() {
+		return
+	}
+
+	if responseData.Data() == nil {
+		return
+	}
+
+	name := getResponseString(responseData, "/name")
+	data.Id = basetypes.NewStringValue(name)
+	data.Name = basetypes.NewStringValue(name)
+	data.Description = basetypes.NewStringValue(getResponseString(responseData, "/description"))
+
+	// ND returns the CA certificates in its own format, the configured value is kept when the certificates are the same.
+	certificate := getResponseString(responseData, "/certificate")
+	if samePemCertificates(stateCertificate.ValueString(), certificate) {
+		data.Certificate = stateCertificate
+	} else {
+		data.Certificate = basetypes.NewStringValue(certificate)
+	}
+	data.setDetails(getCertificateDetails(ctx, diags, certificate))
+
+	usages, setDiags := types.SetValueFrom(ctx, types.StringType, getTrustedCaUsages(getStringElements(getResponseList(responseData, "/usages"))))
+	diags.Append(setDiags...)
+	data.Usages = usages
+}
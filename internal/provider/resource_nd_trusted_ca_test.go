@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceNdTrustedCa(t *testing.T) {
+	rootPem, root, rootKey := newTestCertificate(t, "Example Root CA", true, nil, nil, nil)
+	intermediatePem, _, _ := newTestCertificate(t, "Example Intermediate CA", true, nil, root, rootKey)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config:             testConfigResourceNdTrustedCa(rootPem, `["clusters"]`),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_trusted_ca.test", "id", "terraform_trusted_ca"),
+					resource.TestCheckResourceAttr("nd_trusted_ca.test", "name", "terraform_trusted_ca"),
+					resource.TestCheckResourceAttr("nd_trusted_ca.test", "description", ""),
+					resource.TestCheckResourceAttr("nd_trusted_ca.test", "usages.#", "1"),
+					resource.TestCheckResourceAttr("nd_trusted_ca.test", "subject", "CN=Example Root CA,O=Example"),
+					resource.TestCheckResourceAttr("nd_trusted_ca.test", "not_after", "2036-01-01T00:00:00Z"),
+				),
+			},
+			// Import and verify values
+			{
+				ResourceName:      "nd_trusted_ca.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config:             testConfigResourceNdTrustedCa(intermediatePem+rootPem, `["clusters", "remote_locations"]`),
+				ExpectNonEmptyPlan: false,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nd_trusted_ca.test", "usages.#", "2"),
+					resource.TestCheckResourceAttr("nd_trusted_ca.test", "subject", "CN=Example Intermediate CA,O=Example"),
+					resource.TestCheckResourceAttr("nd_trusted_ca.test", "issuer", "CN=Example Root CA,O=Example"),
+				),
+			},
+		},
+	})
+}
+
+// Validate Trusted CA Errors
+func TestAccResourceNdTrustedCaError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfigResourceNdTrustedCa("invalid", `["clusters"]`),
+				ExpectError: regexp.MustCompile("The 'certificate' attribute must be a PEM encoded CA certificate"),
+			},
+		},
+	})
+}
+
+func TestTrustedCaValidateConfig(t *testing.T) {
+	caPem, ca, caKey := newTestCertificate(t, "Example CA", true, nil, nil, nil)
+	certificatePem, _, _ := newTestCertificate(t, "nd.example.com", false, nil, ca, caKey)
+
+	r := &TrustedCaResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		certificate string
+		warning     string
+		expectError bool
+	}{
+		{caPem, "", false},
+		{certificatePem + caPem, "The certificate 'CN=nd.example.com,O=Example' is not a CA certificate", false},
+		{"-----BEGIN CERTIFICATE-----\nMAA=\n-----END CERTIFICATE-----\n", "", true},
+	}
+	for _, test := range tests {
+		data := getBaseTrustedCaResourceModel()
+		data.Name = basetypes.NewStringValue("ca")
+		data.Certificate = basetypes.NewStringValue(test.certificate)
+		data.Usages = basetypes.NewSetValueMust(types.StringType, nil)
+		resp := &fwresource.ValidateConfigResponse{}
+		r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: newTestConfig(t, tfsdk.State{Schema: schemaResp.Schema}, data)}, resp)
+		if resp.Diagnostics.HasError() != test.expectError {
+			t.Errorf("unexpected diagnostics %v, expected error %t", resp.Diagnostics, test.expectError)
+		}
+		warnings := resp.Diagnostics.Warnings()
+		if (test.warning == "") != (len(warnings) == 0) || (test.warning != "" && !strings.HasPrefix(warnings[0].Detail(), test.warning)) {
+			t.Errorf("unexpected warnings %v, expected %q", warnings, test.warning)
+		}
+	}
+}
+
+func TestTrustedCaAttributes(t *testing.T) {
+	caPem, _, _ := newTestCertificate(t, "Example CA", true, nil, nil, nil)
+
+	var sent map[string]interface{}
+	client := newTestProviderClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/infra/trustedCAs" && r.Method == "POST":
+			json.NewDecoder(r.Body).Decode(&sent)
+			json.NewEncoder(w).Encode(sent)
+		case r.URL.Path == "/api/v1/infra/trustedCAs/private_ca" && sent != nil:
+			// ND returns the CA certificate without the trailing line break and the usages it does not know.
+			fmt.Fprintf(w, `{"name": "private_ca", "description": "", "certificate": %q, "usages": ["clusters", "loginDomains", "kafka"]}`, strings.TrimSpace(caPem))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	data := getBaseTrustedCaResourceModel()
+	data.Name = basetypes.NewStringValue("private_ca")
+	data.Description = basetypes.NewStringValue("")
+	data.Certificate = basetypes.NewStringValue(caPem)
+	data.Usages = basetypes.NewSetValueMust(types.StringType, []attr.Value{basetypes.NewStringValue("clusters"), basetypes.NewStringValue("login_domains")})
+
+	client.DoRestRequest(ctx, &diags, "/api/v1/infra/trustedCAs", "POST", getTrustedCaJsonPayload(ctx, &diags, data))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if usages := fmt.Sprint(sent["usages"]); usages != "[clusters loginDomains]" {
+		t.Errorf("unexpected usages sent %s", usages)
+	}
+
+	data.Id = basetypes.NewStringValue("private_ca")
+	getAndSetTrustedCaAttributes(ctx, &diags, client, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Certificate.ValueString() != caPem {
+		t.Errorf("expected the configured CA certificate to be kept, got %s", data.Certificate)
+	}
+	if data.Subject.ValueString() != "CN=Example CA,O=Example" || data.Issuer.ValueString() != "CN=Example CA,O=Example" {
+		t.Errorf("unexpected subject %s and issuer %s", data.Subject, data.Issuer)
+	}
+	if data.NotBefore.ValueString() != "2026-01-01T00:00:00Z" || data.NotAfter.ValueString() != "2036-01-01T00:00:00Z" {
+		t.Errorf("unexpected validity %s to %s", data.NotBefore, data.NotAfter)
+	}
+	if data.Usages.String() != `["clusters","login_domains"]` {
+		t.Errorf("unexpected usages %s", data.Usages)
+	}
+}
+
+// testConfigResourceNdTrustedCa returns the configuration of a trusted CA with the PEM encoded certificate and the usages.
+func testConfigResourceNdTrustedCa(certificate, usages string) string {
+	return fmt.Sprintf(`
+resource "nd_trusted_ca" "test" {
+  name        = "terraform_trusted_ca"
+  certificate = <<-EOT
+%s
+EOT
+  usages      = %s
+}
+`, strings.TrimSpace(certificate), usages)
+}
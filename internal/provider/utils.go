@@ -2,14 +2,486 @@ package provider
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/CiscoDevNet/terraform-provider-nd/internal/client"
+	"github.com/CiscoDevNet/terraform-provider-nd/nd"
+	"github.com/Jeffail/gabs/v2"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const (
+	inbandEpgDnPrefix    = "uni/tn-mgmt/mgmtp-default/inb-"
+	inbandEpgDnSeparator = "/inb-"
+)
+
+// getInbandEpgDn returns the distinguished name of the in-band EPG of the APIC management tenant with the name.
+func getInbandEpgDn(name string) string {
+	return inbandEpgDnPrefix + name
+}
+
+// getInbandEpgName returns the name of the in-band EPG from the distinguished name or an empty string when the distinguished name is not an in-band EPG.
+func getInbandEpgName(dn string) string {
+	lastIndex := strings.LastIndex(dn, inbandEpgDnSeparator)
+	if lastIndex == -1 {
+		return ""
+	}
+	return dn[lastIndex+len(inbandEpgDnSeparator):]
+}
+
 func getStringAttributeValue(ctx context.Context, attributeValue basetypes.StringValue, envKey string) string {
 	if attributeValue.ValueString() == "" {
 		return os.Getenv(envKey)
 	}
 	return attributeValue.ValueString()
 }
+
+// setPayloadValue sets the value at the location of the JSON pointer in the payload, any missing parent objects are created.
+func setPayloadValue(diags *diag.Diagnostics, payload *gabs.Container, value interface{}, pointer string) {
+	if _, err := payload.SetJSONPointer(value, pointer); err != nil {
+		diags.AddError(
+			"Construction of json payload failed",
+			fmt.Sprintf("Error: %s. Please report this issue to the provider developers.", err),
+		)
+	}
+}
+
+// getResponseValue returns the value at the location of the JSON pointer in the response or nil when it is not found.
+func getResponseValue(responseData *gabs.Container, pointer string) interface{} {
+	value, err := responseData.JSONPointer(pointer)
+	if err != nil {
+		return nil
+	}
+	return value.Data()
+}
+
+// getResponseString returns the string at the JSON pointer of the response, an empty string is returned when the value is not a string.
+func getResponseString(responseData *gabs.Container, pointer string) string {
+	value, _ := getResponseValue(responseData, pointer).(string)
+	return value
+}
+
+// getResponseInt64 returns the number at the JSON pointer of the response, zero is returned when the value is not a number.
+func getResponseInt64(responseData *gabs.Container, pointer string) int64 {
+	value, _ := getResponseValue(responseData, pointer).(float64)
+	return int64(value)
+}
+
+// getResponseList returns the list at the JSON pointer of the response, nil is returned when the value is not a list.
+func getResponseList(responseData *gabs.Container, pointer string) []interface{} {
+	values, _ := getResponseValue(responseData, pointer).([]interface{})
+	return values
+}
+
+// getResponseItems returns the objects of a list response, ND returns the objects in an "items" array or as the array itself.
+func getResponseItems(responseData *gabs.Container) []*gabs.Container {
+	if responseData == nil || responseData.Data() == nil {
+		return nil
+	}
+	if responseData.Exists("items") {
+		return responseData.S("items").Children()
+	}
+	if _, ok := responseData.Data().([]interface{}); ok {
+		return responseData.Children()
+	}
+	return nil
+}
+
+func getStringElements(values []interface{}) []string {
+	elements := make([]string, 0, len(values))
+	for _, value := range values {
+		if element, ok := value.(string); ok {
+			elements = append(elements, element)
+		}
+	}
+	return elements
+}
+
+// getTimeout returns the duration of the timeout attribute or the default timeout when the attribute is not set.
+func getTimeout(diags *diag.Diagnostics, timeout types.String, defaultTimeout time.Duration) time.Duration {
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return defaultTimeout
+	}
+	duration, err := time.ParseDuration(timeout.ValueString())
+	if err != nil || duration <= 0 {
+		diags.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid timeout",
+			fmt.Sprintf("The timeout '%s' must be a positive duration, ie '30m' or '1h'.", timeout.ValueString()),
+		)
+	}
+	return duration
+}
+
+// waitForTask waits until the ND task is done and sends the status changes of the task as action progress events.
+func waitForTask(ctx context.Context, diags *diag.Diagnostics, client *client.Client, task *nd.Task, description string, sendProgress func(action.InvokeProgressEvent)) {
+	progress := func(task *nd.Task) {
+		tflog.Debug(ctx, fmt.Sprintf("%s task '%s' status: %s", description, task.Id, task))
+		if sendProgress != nil {
+			sendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("%s: %s", description, task)})
+		}
+	}
+
+	if task.Id == "" {
+		progress(task)
+		diags.AddWarning(
+			fmt.Sprintf("%s started without task", description),
+			"ND did not return a task, the progress of the operation cannot be tracked.",
+		)
+		return
+	}
+
+	if _, err := client.Tasks.Wait(ctx, task.Id, progress); err != nil {
+		diags.AddError(
+			fmt.Sprintf("%s failed", description),
+			getTaskErrorDetail(err),
+		)
+	}
+}
+
+// getTransferProgress returns the progress function of an upload or a download, the progress is logged every 10 percent,
+// or every 100 MiB when the size is unknown, and sent as action progress events when sendProgress is not nil.
+func getTransferProgress(ctx context.Context, description string, sendProgress func(action.InvokeProgressEvent)) nd.ProgressFunc {
+	reported := int64(-1)
+	return func(sent, total int64) {
+		step, message := sent/(100<<20), fmt.Sprintf("%s: %d bytes", description, sent)
+		if total > 0 {
+			step, message = sent*10/total, fmt.Sprintf("%s: %d%% (%d of %d bytes)", description, sent*100/total, sent, total)
+		}
+		if step <= reported {
+			return
+		}
+		reported = step
+		tflog.Info(ctx, message)
+		if sendProgress != nil {
+			sendProgress(action.InvokeProgressEvent{Message: message})
+		}
+	}
+}
+
+// getTaskErrorDetail returns the detail of the diagnostic of a task error, the errors reported by ND for a failed task
+// are listed one per line.
+func getTaskErrorDetail(err error) string {
+	var taskErr *nd.TaskError
+	if !errors.As(err, &taskErr) || len(taskErr.Task.Errors) == 0 {
+		return fmt.Sprintf("Error: %s", err)
+	}
+	detail := fmt.Sprintf("Error: task %s %s", taskErr.Task.Id, strings.ToLower(taskErr.Task.Status))
+	if taskErr.Task.Message != "" {
+		detail = fmt.Sprintf("%s: %s", detail, taskErr.Task.Message)
+	}
+	detail += "\n\nErrors reported by ND:"
+	for _, message := range taskErr.Task.Errors {
+		detail += fmt.Sprintf("\n  - %s", message)
+	}
+	return detail
+}
+
+// getResponseNames returns the names of a list of objects, ND returns the objects as names or as objects with a name.
+func getResponseNames(value interface{}) []string {
+	values, ok := value.([]interface{})
+	if !ok {
+		return []string{}
+	}
+	names := make([]string, 0, len(values))
+	for _, element := range values {
+		switch element := element.(type) {
+		case string:
+			names = append(names, element)
+		case map[string]interface{}:
+			if name, ok := element["name"].(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// previousConfigKey is the key of the private state in which the cluster configuration resources store the configuration
+// captured before their creation, the configuration is restored when the resource is destroyed.
+const previousConfigKey = "previous_config"
+
+// getClusterConfig returns the current cluster-wide configuration of the feature.
+func getClusterConfig(ctx context.Context, diags *diag.Diagnostics, client *client.Client, feature *nd.Feature) *gabs.Container {
+	apiPath := client.GetPath(ctx, diags, feature)
+	if diags.HasError() {
+		return nil
+	}
+	return client.DoRestRequest(ctx, diags, apiPath, "GET", nil)
+}
+
+// putClusterConfig sends the payload of the cluster-wide configuration of the feature when force is set or when the
+// comparison payload differs from the current configuration. The current configuration is read and sent while the path
+// is locked, the changes of the configuration are serialized by the client. The configuration read before the changes
+// is returned, ie to capture the configuration restored when the resource is destroyed.
+func putClusterConfig(ctx context.Context, diags *diag.Diagnostics, client *client.Client, feature *nd.Feature, payload, comparePayload *gabs.Container, force bool) *gabs.Container {
+	apiPath := client.GetPath(ctx, diags, feature)
+	if diags.HasError() {
+		return nil
+	}
+
+	unlock := client.LockPath(apiPath)
+	defer unlock()
+
+	current := client.DoRestRequest(ctx, diags, apiPath, "GET", nil)
+	if diags.HasError() {
+		return nil
+	}
+
+	if !force && !clusterConfigChanged(current, comparePayload) {
+		tflog.Debug(ctx, fmt.Sprintf("The %s configuration is up to date", feature.Name))
+		return current
+	}
+
+	client.DoRestRequest(ctx, diags, apiPath, "PUT", payload)
+	return current
+}
+
+// restoreClusterConfig sends the cluster-wide configuration of the feature captured before the creation of the resource.
+// The configuration is left unchanged when no configuration was captured, ie when the resource was imported.
+// The values at the omitted JSON pointers are removed from the captured configuration, ie the secrets which are never
+// returned by ND, so that ND keeps their current values instead of values without their secrets.
+func restoreClusterConfig(ctx context.Context, diags *diag.Diagnostics, client *client.Client, feature *nd.Feature, previous []byte, omittedPointers ...string) {
+	if len(previous) == 0 {
+		diags.AddWarning(
+			fmt.Sprintf("The %s configuration was not restored", feature.Name),
+			"The configuration before the creation of the resource was not captured, the current configuration is left unchanged on ND.",
+		)
+		return
+	}
+
+	payload, err := gabs.ParseJSON(previous)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to restore the %s configuration", feature.Name),
+			fmt.Sprintf("Error: %s", err),
+		)
+		return
+	}
+	for _, pointer := range omittedPointers {
+		// The error is ignored because the value is not always present in the captured configuration.
+		_ = payload.Delete(strings.Split(strings.TrimPrefix(pointer, "/"), "/")...)
+	}
+
+	apiPath := client.GetPath(ctx, diags, feature)
+	if diags.HasError() {
+		return
+	}
+
+	unlock := client.LockPath(apiPath)
+	defer unlock()
+
+	client.DoRestRequest(ctx, diags, apiPath, "PUT", payload)
+}
+
+// clusterConfigChanged reports whether a value of the payload differs from the current configuration, the values of the
+// current configuration which are not in the payload are ignored.
+func clusterConfigChanged(current, payload *gabs.Container) bool {
+	if current == nil || current.Data() == nil {
+		return true
+	}
+	// The payload is parsed again to compare JSON values, ie float64 numbers instead of int64.
+	expected, err := gabs.ParseJSON(payload.Bytes())
+	if err != nil {
+		return true
+	}
+	return !containsConfigValue(current.Data(), expected.Data())
+}
+
+// containsConfigValue reports whether the value contains the expected value, the keys of the objects which are not
+// expected are ignored and the lists must contain the expected elements in the same order.
+// ND omits the values which are not set, a missing value contains an expected zero value, ie false or an empty list.
+func containsConfigValue(value, expected interface{}) bool {
+	if value == nil && isZeroConfigValue(expected) {
+		return true
+	}
+	switch expected := expected.(type) {
+	case map[string]interface{}:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, expectedValue := range expected {
+			if !containsConfigValue(object[key], expectedValue) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		list, ok := value.([]interface{})
+		if !ok || len(list) != len(expected) {
+			return false
+		}
+		for index, expectedValue := range expected {
+			if !containsConfigValue(list[index], expectedValue) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(value, expected)
+	}
+}
+
+func isZeroConfigValue(value interface{}) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !value
+	case float64:
+		return value == 0
+	case string:
+		return value == ""
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(value) == 0
+	}
+	return false
+}
+
+// updateClusterConfigList changes the list of strings at the JSON pointer of the cluster-wide configuration of the
+// feature with the update function and sends the configuration when the list is changed. The other elements of the list
+// and values of the configuration are preserved, the changes of the configuration are serialized by the client.
+func updateClusterConfigList(ctx context.Context, diags *diag.Diagnostics, client *client.Client, feature *nd.Feature, pointer string, update func(values []string) []string) {
+	apiPath := client.GetPath(ctx, diags, feature)
+	if diags.HasError() {
+		return
+	}
+
+	unlock := client.LockPath(apiPath)
+	defer unlock()
+
+	configData := client.DoRestRequest(ctx, diags, apiPath, "GET", nil)
+	if diags.HasError() {
+		return
+	}
+	if configData == nil || configData.Data() == nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to read the %s", strings.ToLower(feature.Name)),
+			fmt.Sprintf("The %s was not returned by ND.", strings.ToLower(feature.Name)),
+		)
+		return
+	}
+
+	values := getStringElements(getResponseList(configData, pointer))
+	updatedValues := update(slices.Clone(values))
+	if slices.Equal(values, updatedValues) {
+		tflog.Debug(ctx, fmt.Sprintf("The %s list '%s' is up to date", strings.ToLower(feature.Name), pointer))
+		return
+	}
+
+	setPayloadValue(diags, configData, updatedValues, pointer)
+	if diags.HasError() {
+		return
+	}
+	client.DoRestRequest(ctx, diags, apiPath, "PUT", configData)
+}
+
+// parsePemCertificates returns the certificates of the PEM value in their order, the blocks of the value which are not
+// certificates, such as a private key, are ignored.
+func parsePemCertificates(value string) ([]*x509.Certificate, error) {
+	certificates := []*x509.Certificate{}
+	rest := []byte(value)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+	if len(certificates) == 0 {
+		return nil, errors.New("the value does not contain a PEM encoded certificate")
+	}
+	return certificates, nil
+}
+
+// samePemCertificates reports whether the PEM values contain the same certificates in the same order, ND may return the
+// certificates with other line breaks or without the blocks which are not certificates.
+func samePemCertificates(value, other string) bool {
+	certificates, err := parsePemCertificates(value)
+	if err != nil {
+		return false
+	}
+	otherCertificates, err := parsePemCertificates(other)
+	if err != nil || len(certificates) != len(otherCertificates) {
+		return false
+	}
+	for index, certificate := range certificates {
+		if !certificate.Equal(otherCertificates[index]) {
+			return false
+		}
+	}
+	return true
+}
+
+// certificateDetails are the computed attributes of the first certificate of a PEM value.
+type certificateDetails struct {
+	Subject                 types.String
+	Issuer                  types.String
+	SubjectAlternativeNames types.List
+	NotBefore               types.String
+	NotAfter                types.String
+}
+
+// getCertificateDetails returns the details of the first certificate of the PEM value, the details are null when the
+// value is empty. The validity dates are formatted in RFC 3339 in UTC.
+func getCertificateDetails(ctx context.Context, diags *diag.Diagnostics, value string) certificateDetails {
+	details := certificateDetails{
+		Subject:                 basetypes.NewStringNull(),
+		Issuer:                  basetypes.NewStringNull(),
+		SubjectAlternativeNames: basetypes.NewListNull(types.StringType),
+		NotBefore:               basetypes.NewStringNull(),
+		NotAfter:                basetypes.NewStringNull(),
+	}
+	if value == "" {
+		return details
+	}
+
+	certificates, err := parsePemCertificates(value)
+	if err != nil {
+		diags.AddError("Invalid certificate", fmt.Sprintf("Failed to parse the PEM encoded certificate: %s", err))
+		return details
+	}
+
+	certificate := certificates[0]
+	subjectAlternativeNames := append([]string{}, certificate.DNSNames...)
+	for _, ip := range certificate.IPAddresses {
+		subjectAlternativeNames = append(subjectAlternativeNames, ip.String())
+	}
+	subjectAlternativeNames = append(subjectAlternativeNames, certificate.EmailAddresses...)
+	for _, uri := range certificate.URIs {
+		subjectAlternativeNames = append(subjectAlternativeNames, uri.String())
+	}
+	subjectAlternativeNamesList, listDiags := types.ListValueFrom(ctx, types.StringType, subjectAlternativeNames)
+	diags.Append(listDiags...)
+
+	details.Subject = basetypes.NewStringValue(certificate.Subject.String())
+	details.Issuer = basetypes.NewStringValue(certificate.Issuer.String())
+	details.SubjectAlternativeNames = subjectAlternativeNamesList
+	details.NotBefore = basetypes.NewStringValue(certificate.NotBefore.UTC().Format(time.RFC3339))
+	details.NotAfter = basetypes.NewStringValue(certificate.NotAfter.UTC().Format(time.RFC3339))
+	return details
+}
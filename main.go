@@ -13,7 +13,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
-// Run "go generate" to format example terraform files and generate the docs for the registry/website
+// Run "go generate" to generate the resources and data sources from the definitions in the gen/definitions directory,
+// format example terraform files and generate the docs for the registry/website
+
+// Run the resource generator, check gen/README.md for more information on the definition format.
+//go:generate go run ./gen
 
 // If you do not have terraform installed, you can remove the formatting command, but its suggested to
 // ensure the documentation is formatted properly.
@@ -0,0 +1,123 @@
+package nd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// The lifetime of the ND login token in seconds.
+const tokenRefreshTime int64 = 1200
+
+// Auth is the login token of the client and its expiry.
+type Auth struct {
+	Token  string
+	Expiry time.Time
+}
+
+func (au *Auth) IsValid() bool {
+	if au.Token != "" && au.Expiry.Unix() > au.estimateExpireTime() {
+		return true
+	}
+	return false
+}
+
+func (t *Auth) CalculateExpiry(willExpire int64) {
+	t.Expiry = time.Unix((time.Now().Unix() + willExpire), 0)
+}
+
+func (t *Auth) estimateExpireTime() int64 {
+	return time.Now().Unix() + 3
+}
+
+type loginRequest struct {
+	UserName   string `json:"userName"`
+	UserPasswd string `json:"userPasswd"`
+	Domain     string `json:"domain,omitempty"`
+}
+
+type loginResponse struct {
+	Token string `json:"token"`
+}
+
+// Login authenticates with the credentials of the client and stores the token used by the following requests.
+// The requests of the client log in automatically when needed, so calling Login is only required to validate the credentials.
+// Nothing is done when the client authenticates with an API key, the API key is sent with each request instead.
+func (c *Client) Login(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.apiKey != "" {
+		return nil
+	}
+	return c.login(ctx)
+}
+
+func (c *Client) login(ctx context.Context) error {
+	if c.username == "" || c.password == "" {
+		return errors.New("username and password must be provided to login to ND")
+	}
+
+	req, err := c.NewRequest(ctx, http.MethodPost, "/login", loginRequest{
+		UserName:   c.username,
+		UserPasswd: c.password,
+		Domain:     c.domain,
+	})
+	if err != nil {
+		return err
+	}
+
+	var response loginResponse
+	_, err = c.do(req, &response, false)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden) {
+			return fmt.Errorf("Invalid Username or Password: %w", err)
+		}
+		return err
+	}
+
+	if response.Token == "" {
+		return errors.New("Invalid Username or Password")
+	}
+
+	if c.authToken == nil {
+		c.authToken = &Auth{}
+	}
+	c.authToken.Token = response.Token
+	c.authToken.CalculateExpiry(tokenRefreshTime)
+
+	return nil
+}
+
+func (c *Client) invalidateToken() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.authToken = nil
+}
+
+func (c *Client) injectAuthenticationHeader(req *http.Request) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.apiKey != "" {
+		req.Header.Set("X-Nd-Username", c.username)
+		req.Header.Set("X-Nd-Apikey", c.apiKey)
+		return nil
+	}
+
+	if c.authToken == nil || !c.authToken.IsValid() {
+		if err := c.login(req.Context()); err != nil {
+			return err
+		}
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.authToken.Token))
+	// The header "Cookie" must be set for the Nexus Dashboard 2.3 and later versions.
+	// The header is also set when the version is not detected yet, ie for the request of the version itself.
+	if c.cachedVersionSupports(FeatureAuthCookie) {
+		req.Header.Set("Cookie", fmt.Sprintf("AuthCookie=%s", c.authToken.Token))
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package nd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// The modes of a configuration restore.
+const (
+	// RestoreModeMerge merges the configuration of the backup with the current configuration of ND.
+	RestoreModeMerge = "merge"
+	// RestoreModeOverwrite replaces the current configuration of ND with the configuration of the backup.
+	RestoreModeOverwrite = "overwrite"
+)
+
+// BackupsService handles the configuration backups of ND.
+type BackupsService service
+
+// Backup is a configuration backup of ND.
+type Backup struct {
+	Name           string `json:"name"`
+	Description    string `json:"description,omitempty"`
+	Type           string `json:"type,omitempty"`
+	RemoteLocation string `json:"remoteLocation,omitempty"`
+	// EncryptionKey is only used to create the backup, it is not returned by ND.
+	EncryptionKey string `json:"encryptionKey,omitempty"`
+	State         string `json:"state,omitempty"`
+	Size          int64  `json:"size,omitempty"`
+	// Checksum is the hex encoded SHA-256 checksum of the backup file.
+	Checksum    string `json:"sha256,omitempty"`
+	CreatedAt   string `json:"createdAt,omitempty"`
+	CompletedAt string `json:"completedAt,omitempty"`
+}
+
+// Restore is a restore of a configuration backup, the backup is identified by the name of a backup known by ND
+// or by the path of a backup file on a remote location.
+type Restore struct {
+	BackupName     string `json:"backupName,omitempty"`
+	RemoteLocation string `json:"remoteLocation,omitempty"`
+	File           string `json:"filePath,omitempty"`
+	Mode           string `json:"mode,omitempty"`
+	EncryptionKey  string `json:"encryptionKey,omitempty"`
+}
+
+var errInvalidRestoreSource = errors.New("the restore requires either the name of a backup or the path of a backup file")
+
+type backupList struct {
+	Items []Backup `json:"items"`
+}
+
+// List returns the configuration backups of ND.
+func (s *BackupsService) List(ctx context.Context) ([]Backup, error) {
+	list := &backupList{}
+	path, err := s.client.featurePath(ctx, FeatureBackups)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// Get returns the configuration backup with the name.
+func (s *BackupsService) Get(ctx context.Context, name string) (*Backup, error) {
+	if name == "" {
+		return nil, errEmptyIdentifier
+	}
+	backup := &Backup{}
+	path, err := s.client.featurePath(ctx, FeatureBackups, name)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, backup); err != nil {
+		return nil, err
+	}
+	return backup, nil
+}
+
+// Create starts an on-demand configuration backup and returns the task of the backup.
+// The ID of the task is empty when ND does not return a task for the backup.
+func (s *BackupsService) Create(ctx context.Context, backup *Backup) (*Task, error) {
+	path, err := s.client.featurePath(ctx, FeatureBackups)
+	if err != nil {
+		return nil, err
+	}
+	task := &Task{}
+	if err = s.client.send(ctx, http.MethodPost, path, backup, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// Delete deletes the configuration backup with the name.
+func (s *BackupsService) Delete(ctx context.Context, name string) error {
+	if name == "" {
+		return errEmptyIdentifier
+	}
+	path, err := s.client.featurePath(ctx, FeatureBackups, name)
+	if err != nil {
+		return err
+	}
+	return s.client.send(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// Restore starts the restore of a configuration backup and returns the task of the restore.
+// The ID of the task is empty when ND does not return a task for the restore.
+func (s *BackupsService) Restore(ctx context.Context, restore *Restore) (*Task, error) {
+	if (restore.BackupName == "") == (restore.File == "") {
+		return nil, errInvalidRestoreSource
+	}
+	path, err := s.client.featurePath(ctx, FeatureRestore)
+	if err != nil {
+		return nil, err
+	}
+	task := &Task{}
+	if err = s.client.send(ctx, http.MethodPost, path, restore, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// Download downloads the backup file of the configuration backup with the name to the destination path, see
+// DownloadFile. The checksum of the file is verified against the checksum, or against the checksum of the backup
+// returned by ND when the checksum is empty.
+func (s *BackupsService) Download(ctx context.Context, name, destination, checksum string, progress ProgressFunc) (*Download, error) {
+	backup, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if checksum == "" {
+		checksum = backup.Checksum
+	}
+	path, err := s.client.featurePath(ctx, FeatureBackups, name, "download")
+	if err != nil {
+		return nil, err
+	}
+	return s.client.DownloadFile(ctx, path, destination, checksum, progress)
+}
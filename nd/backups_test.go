@@ -0,0 +1,130 @@
+package nd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const backupsPath = "/api/v1/infra/backups"
+
+func TestBackups(t *testing.T) {
+	backups := map[string]Backup{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		name := ""
+		if len(r.URL.Path) > len(backupsPath) {
+			name = r.URL.Path[len(backupsPath)+1:]
+		}
+		switch r.Method {
+		case "GET":
+			if name == "" {
+				list := backupList{}
+				for _, backup := range backups {
+					list.Items = append(list.Items, backup)
+				}
+				json.NewEncoder(w).Encode(list)
+				return
+			}
+			backup, ok := backups[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(backup)
+		case "POST":
+			var backup Backup
+			json.NewDecoder(r.Body).Decode(&backup)
+			backup.EncryptionKey = ""
+			backup.State = "inProgress"
+			backups[backup.Name] = backup
+			w.WriteHeader(http.StatusAccepted)
+		case "DELETE":
+			delete(backups, name)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	if _, err := client.Backups.Create(ctx, &Backup{Name: "daily", Type: "config", EncryptionKey: "Secret123!"}); err != nil {
+		t.Fatal(err)
+	}
+
+	backup, err := client.Backups.Get(ctx, "daily")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backup.State != "inProgress" || backup.EncryptionKey != "" {
+		t.Errorf("Backups.Get() = %+v, expected the created backup", backup)
+	}
+
+	list, err := client.Backups.List(ctx)
+	if err != nil || len(list) != 1 {
+		t.Errorf("Backups.List() = %v, %v, expected one backup", list, err)
+	}
+
+	if err := client.Backups.Delete(ctx, "daily"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Backups.Get(ctx, "daily"); !IsNotFound(err) {
+		t.Errorf("Backups.Get() error = %v, expected a not found error", err)
+	}
+}
+
+func TestBackupsRestore(t *testing.T) {
+	var restore Restore
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/infra/restore" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&restore)
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(Task{Id: "task1", Status: TaskStatusPending})
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	task, err := client.Backups.Restore(ctx, &Restore{BackupName: "daily", Mode: RestoreModeOverwrite, EncryptionKey: "Secret123!"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Id != "task1" || restore.BackupName != "daily" || restore.Mode != RestoreModeOverwrite || restore.EncryptionKey != "Secret123!" {
+		t.Errorf("Backups.Restore() = %+v with payload %+v, expected the task of the restore", task, restore)
+	}
+
+	for _, invalid := range []*Restore{{}, {BackupName: "daily", File: "/backups/daily.tgz"}} {
+		if _, err := client.Backups.Restore(ctx, invalid); err != errInvalidRestoreSource {
+			t.Errorf("Backups.Restore(%+v) error = %v, expected %v", invalid, err, errInvalidRestoreSource)
+		}
+	}
+}
+
+func TestBackupsDownload(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/infra/backups/daily":
+			io.WriteString(w, `{"name": "daily", "sha256": "`+downloadChecksum()+`"}`)
+		case "/api/v1/infra/backups/daily/download":
+			http.ServeContent(w, r, "daily.tgz", time.Time{}, strings.NewReader(downloadContent[:9]))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	client := newTestClient(t, server)
+	destination := filepath.Join(t.TempDir(), "daily.tgz")
+
+	// The file is verified against the checksum of the backup returned by ND.
+	if _, err := client.Backups.Download(context.Background(), "daily", destination, "", nil); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("Backups.Download() error = %v, expected a checksum mismatch", err)
+	}
+	if _, err := client.Backups.Download(context.Background(), "missing", destination, "", nil); !IsNotFound(err) {
+		t.Errorf("Backups.Download() error = %v, expected a not found error", err)
+	}
+}
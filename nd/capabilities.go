@@ -0,0 +1,282 @@
+package nd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Feature is a part of the ND API whose availability and path depend on the version of the ND platform.
+type Feature struct {
+	// Name is used in the error returned when the feature is not supported by the ND version.
+	Name         string
+	Capabilities []Capability
+}
+
+// Capability is the API of a feature for a range of ND versions.
+type Capability struct {
+	// Minimum is the first ND version of the range, an empty string means that the range has no lower bound.
+	Minimum string
+	// Maximum is the first ND version after the range, an empty string means that the range has no upper bound.
+	Maximum string
+	// Path is the API path of the feature for the range.
+	Path string
+}
+
+// Features of the ND API used by the services of the client.
+var (
+	FeatureAuthCookie = &Feature{
+		Name:         "The authentication cookie",
+		Capabilities: []Capability{{Minimum: "2.3"}},
+	}
+	FeatureClusters = &Feature{
+		Name:         "Multi-cluster connectivity",
+		Capabilities: []Capability{{Path: "/api/v1/infra/clusters"}},
+	}
+	FeatureLocalUsers = &Feature{
+		Name: "Local users",
+		Capabilities: []Capability{
+			{Maximum: "4.1", Path: "/nexus/infra/api/aaa/v4/localusers"},
+			{Minimum: "4.1", Path: "/api/v1/infra/aaa/localUsers"},
+		},
+	}
+	FeatureSecurityDomains = &Feature{
+		Name: "Security domains",
+		Capabilities: []Capability{
+			{Maximum: "4.1", Path: "/nexus/infra/api/aaa/v4/securitydomains"},
+			{Minimum: "4.1", Path: "/api/v1/infra/aaa/securityDomains"},
+		},
+	}
+	FeatureLoginDomains = &Feature{
+		Name: "Login domains",
+		Capabilities: []Capability{
+			{Maximum: "4.1", Path: "/nexus/infra/api/aaa/v4/logindomains"},
+			{Minimum: "4.1", Path: "/api/v1/infra/aaa/loginDomains"},
+		},
+	}
+	FeatureSingleSignOnLoginDomains = &Feature{
+		Name:         "SAML and OIDC login domains",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/aaa/loginDomains"}},
+	}
+	FeatureRoles = &Feature{
+		Name: "Custom roles",
+		Capabilities: []Capability{
+			{Minimum: "3.0", Maximum: "4.1", Path: "/nexus/infra/api/aaa/v4/roles"},
+			{Minimum: "4.1", Path: "/api/v1/infra/aaa/roles"},
+		},
+	}
+	FeatureAPIKeys = &Feature{
+		Name: "API keys",
+		Capabilities: []Capability{
+			{Minimum: "3.0", Maximum: "4.1", Path: "/nexus/infra/api/aaa/v4/apikeys"},
+			{Minimum: "4.1", Path: "/api/v1/infra/aaa/apiKeys"},
+		},
+	}
+	FeatureBackups = &Feature{
+		Name:         "Configuration backups",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/backups"}},
+	}
+	FeatureRestore = &Feature{
+		Name:         "Configuration restore",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/restore"}},
+	}
+	FeatureNTPServers = &Feature{
+		Name:         "NTP servers",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/clusterConfig/ntp"}},
+	}
+	FeatureDNSConfig = &Feature{
+		Name:         "DNS configuration",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/clusterConfig/dns"}},
+	}
+	FeatureClusterNetworkConfig = &Feature{
+		Name:         "Cluster network configuration",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/clusterConfig/network"}},
+	}
+	FeatureExternalServicePools = &Feature{
+		Name:         "External service pools",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/clusterConfig/externalServicePools"}},
+	}
+	FeatureRemoteLocations = &Feature{
+		Name:         "Backup remote locations",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/remoteLocations"}},
+	}
+	FeatureBackupSchedules = &Feature{
+		Name:         "Backup schedules",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/backupSchedules"}},
+	}
+	FeatureFirmwareImages = &Feature{
+		Name:         "Firmware images",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/firmware/images"}},
+	}
+	FeatureFirmwareUploads = &Feature{
+		Name:         "Firmware image uploads",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/firmware/uploads"}},
+	}
+	FeatureClusterUpgrade = &Feature{
+		Name:         "Cluster upgrade",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/firmware/upgrade"}},
+	}
+	FeatureServices = &Feature{
+		Name:         "Services",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/services"}},
+	}
+	FeatureServiceImages = &Feature{
+		Name:         "Service images",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/services/images"}},
+	}
+	FeatureEventExports = &Feature{
+		Name:         "Event exports",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/eventExports"}},
+	}
+	FeatureSMTPConfig = &Feature{
+		Name:         "SMTP configuration",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/clusterConfig/smtp"}},
+	}
+	FeatureEmailNotifications = &Feature{
+		Name:         "Email notifications",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/emailNotifications"}},
+	}
+	FeatureCertificates = &Feature{
+		Name:         "Certificates",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/certificates"}},
+	}
+	FeatureUICertificate = &Feature{
+		Name:         "Web UI certificate",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/clusterConfig/uiCertificate"}},
+	}
+	FeatureTrustedCAs = &Feature{
+		Name:         "Trusted CA certificates",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/trustedCAs"}},
+	}
+	FeatureTasks = &Feature{
+		Name:         "Tasks",
+		Capabilities: []Capability{{Minimum: "4.1", Path: "/api/v1/infra/tasks"}},
+	}
+)
+
+// UnsupportedError is returned when a feature is not supported by the version of the ND platform.
+type UnsupportedError struct {
+	Feature *Feature
+	Version *Version
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("%s requires ND %s, the version of ND is %s", e.Feature.Name, e.Feature.Requirement(), e.Version)
+}
+
+// Requirement returns the ND versions supporting the feature, ie ">= 4.1" or "< 4.1 or >= 4.2".
+func (f *Feature) Requirement() string {
+	requirements := []string{}
+	for _, capability := range f.Capabilities {
+		bounds := []string{}
+		if capability.Minimum != "" {
+			bounds = append(bounds, fmt.Sprintf(">= %s", capability.Minimum))
+		}
+		if capability.Maximum != "" {
+			bounds = append(bounds, fmt.Sprintf("< %s", capability.Maximum))
+		}
+		if len(bounds) == 0 {
+			return "of any version"
+		}
+		requirements = append(requirements, strings.Join(bounds, " and "))
+	}
+	return strings.Join(requirements, " or ")
+}
+
+// Capability returns the capability of the feature for the ND version, an *UnsupportedError is returned when the
+// feature is not supported.
+func (f *Feature) Capability(version *Version) (*Capability, error) {
+	for _, capability := range f.Capabilities {
+		if capability.Minimum != "" && version.Compare(MustParseVersion(capability.Minimum)) < 0 {
+			continue
+		}
+		if capability.Maximum != "" && version.Compare(MustParseVersion(capability.Maximum)) >= 0 {
+			continue
+		}
+		return &capability, nil
+	}
+	return nil, &UnsupportedError{Feature: f, Version: version}
+}
+
+// PlatformVersion returns the version of the ND platform.
+// The version is requested once and cached by the client, unless it was provided with WithPlatformVersion.
+func (c *Client) PlatformVersion(ctx context.Context) (*Version, error) {
+	// The detection is serialized separately from the cached version, the version request itself reads the cached
+	// version to decide which authentication headers are sent.
+	c.detectMutex.Lock()
+	defer c.detectMutex.Unlock()
+
+	if version := c.cachedVersion(); version != nil {
+		return version, nil
+	}
+
+	version, err := c.Version.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to detect the version of ND: %w", err)
+	}
+	c.logger.Printf("[DEBUG] Detected ND version %s", version)
+
+	c.versionMutex.Lock()
+	c.platformVersion = version
+	c.versionMutex.Unlock()
+	return version, nil
+}
+
+// ResetPlatformVersion clears the cached version of the ND platform, ie after an upgrade of the cluster, the version
+// is requested again by the next call which depends on it. A version provided with WithPlatformVersion is also cleared.
+func (c *Client) ResetPlatformVersion() {
+	c.versionMutex.Lock()
+	defer c.versionMutex.Unlock()
+	c.platformVersion = nil
+}
+
+func (c *Client) cachedVersion() *Version {
+	c.versionMutex.Lock()
+	defer c.versionMutex.Unlock()
+	return c.platformVersion
+}
+
+// Capability returns the capability of the feature for the version of the ND platform, an *UnsupportedError is
+// returned when the feature is not supported.
+func (c *Client) Capability(ctx context.Context, feature *Feature) (*Capability, error) {
+	version, err := c.PlatformVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return feature.Capability(version)
+}
+
+// Supports reports whether the feature is supported by the version of the ND platform.
+func (c *Client) Supports(ctx context.Context, feature *Feature) (bool, error) {
+	_, err := c.Capability(ctx, feature)
+	if _, ok := err.(*UnsupportedError); ok {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// featurePath returns the API path of the feature for the version of the ND platform followed by the escaped elements,
+// ie the identifier of an object.
+func (c *Client) featurePath(ctx context.Context, feature *Feature, elements ...string) (string, error) {
+	capability, err := c.Capability(ctx, feature)
+	if err != nil {
+		return "", err
+	}
+	path := capability.Path
+	for _, element := range elements {
+		path = fmt.Sprintf("%s/%s", path, url.PathEscape(element))
+	}
+	return path, nil
+}
+
+// cachedVersionSupports reports whether the feature is supported by the cached version of the ND platform.
+// The feature is considered supported when the version is not yet detected.
+func (c *Client) cachedVersionSupports(feature *Feature) bool {
+	version := c.cachedVersion()
+	if version == nil {
+		return true
+	}
+	_, err := feature.Capability(version)
+	return err == nil
+}
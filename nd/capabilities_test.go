@@ -0,0 +1,119 @@
+package nd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFeatureCapability(t *testing.T) {
+	tests := []struct {
+		version string
+		path    string
+	}{
+		{"3.0.1i", "/nexus/infra/api/aaa/v4/localusers"},
+		{"3.2.2m", "/nexus/infra/api/aaa/v4/localusers"},
+		{"4.1", "/api/v1/infra/aaa/localUsers"},
+		{"4.1.1g", "/api/v1/infra/aaa/localUsers"},
+	}
+	for _, test := range tests {
+		capability, err := FeatureLocalUsers.Capability(MustParseVersion(test.version))
+		if err != nil || capability.Path != test.path {
+			t.Errorf("FeatureLocalUsers.Capability(%s) = %v, %v, expected path %s", test.version, capability, err, test.path)
+		}
+	}
+
+	capability, err := FeatureClusters.Capability(MustParseVersion("3.2.1e"))
+	if err != nil || capability.Path != "/api/v1/infra/clusters" {
+		t.Errorf("FeatureClusters.Capability(3.2.1e) = %v, %v, expected the path of any version", capability, err)
+	}
+
+	_, err = FeatureServices.Capability(MustParseVersion("3.2.1e"))
+	var unsupportedErr *UnsupportedError
+	if !errors.As(err, &unsupportedErr) {
+		t.Fatalf("FeatureServices.Capability(3.2.1e) error = %v, expected an UnsupportedError", err)
+	}
+	expected := "Services requires ND >= 4.1, the version of ND is 3.2.1e"
+	if err.Error() != expected {
+		t.Errorf("UnsupportedError.Error() = %q, expected %q", err.Error(), expected)
+	}
+}
+
+func TestFeatureRequirement(t *testing.T) {
+	tests := []struct {
+		feature  *Feature
+		expected string
+	}{
+		{FeatureServices, ">= 4.1"},
+		{FeatureClusters, "of any version"},
+		{&Feature{Capabilities: []Capability{{Maximum: "4.1"}, {Minimum: "4.2"}}}, "< 4.1 or >= 4.2"},
+		{&Feature{Capabilities: []Capability{{Minimum: "3.1", Maximum: "4.1"}}}, ">= 3.1 and < 4.1"},
+	}
+	for _, test := range tests {
+		if actual := test.feature.Requirement(); actual != test.expected {
+			t.Errorf("Requirement() = %q, expected %q", actual, test.expected)
+		}
+	}
+}
+
+func TestPlatformVersion(t *testing.T) {
+	var versionRequests atomic.Int64
+	version := "2.2.2d"
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/version.json":
+			versionRequests.Add(1)
+			major, minor, maintenance, patch := 0, 0, 0, ""
+			fmt.Sscanf(version, "%d.%d.%d%s", &major, &minor, &maintenance, &patch)
+			fmt.Fprintf(w, `{"major": %d, "minor": %d, "maintenance": %d, "patch": "%s"}`, major, minor, maintenance, patch)
+		case "/api/v1/test":
+			// The cookie is sent until the version is detected and must not be sent to ND versions before 2.3.
+			if r.Header.Get("Cookie") != "" {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}
+	})
+	ctx := context.Background()
+
+	client := newTestClient(t, server, func(c *Client) error {
+		c.platformVersion = nil
+		return nil
+	})
+	for range 3 {
+		detected, err := client.PlatformVersion(ctx)
+		if err != nil || detected.String() != version {
+			t.Fatalf("PlatformVersion() = %v, %v, expected %s", detected, err, version)
+		}
+	}
+	if versionRequests.Load() != 1 {
+		t.Errorf("PlatformVersion() requested the version %d times, expected the version to be cached", versionRequests.Load())
+	}
+	if err := client.get(ctx, "/api/v1/test", nil); err != nil {
+		t.Errorf("get() error = %v, expected the AuthCookie to be omitted for ND %s", err, version)
+	}
+
+	supported, err := client.Supports(ctx, FeatureServices)
+	if err != nil || supported {
+		t.Errorf("Supports(FeatureServices) = %v, %v, expected false", supported, err)
+	}
+
+	_, err = client.Services.List(ctx)
+	if err == nil || !strings.Contains(err.Error(), "requires ND >= 4.1") {
+		t.Errorf("Services.List() error = %v, expected an UnsupportedError", err)
+	}
+
+	// The version is requested again after the cached version is cleared, ie after an upgrade.
+	version = "4.1.1g"
+	client.ResetPlatformVersion()
+	supported, err = client.Supports(ctx, FeatureServices)
+	if err != nil || !supported {
+		t.Errorf("Supports(FeatureServices) = %v, %v, expected true after the upgrade to ND %s", supported, err, version)
+	}
+	if versionRequests.Load() != 2 {
+		t.Errorf("PlatformVersion() requested the version %d times, expected the version to be requested again", versionRequests.Load())
+	}
+}
@@ -0,0 +1,359 @@
+package nd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default timeout for NGINX in ND is 90 Seconds.
+// Allow the client to set a shorter or longer time depending on their
+// environment
+const DefaultReqTimeoutVal int = 100
+const DefaultMaxRetries int = 2
+const DefaultBackoffMinDelay time.Duration = 4 * time.Second
+const DefaultBackoffMaxDelay time.Duration = 60 * time.Second
+const DefaultBackoffDelayFactor float64 = 3
+
+// Logger is the interface used by the client to log debug messages, it is satisfied by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+type discardLogger struct{}
+
+func (discardLogger) Printf(format string, v ...interface{}) {}
+
+// Client manages the communication with the ND API.
+type Client struct {
+	baseURL            *url.URL
+	httpClient         *http.Client
+	authToken          *Auth
+	mutex              sync.Mutex
+	username           string
+	password           string
+	apiKey             string
+	domain             string
+	insecure           bool
+	proxyUrl           *url.URL
+	proxyHeader        http.Header
+	logger             Logger
+	skipLoggingPayload bool
+	maxRetries         int
+	backoffMinDelay    time.Duration
+	backoffMaxDelay    time.Duration
+	backoffDelayFactor float64
+	platformVersion    *Version
+	versionMutex       sync.Mutex
+	detectMutex        sync.Mutex
+	taskPollInterval   time.Duration
+	uploadChunkSize    int64
+	pathLocks          sync.Map
+
+	// Services used to manage the different parts of the ND API.
+	Clusters      *ClustersService
+	Version       *VersionService
+	Users         *UsersService
+	Backups       *BackupsService
+	Tasks         *TasksService
+	Firmware      *FirmwareService
+	Services      *ServicesService
+	Notifications *NotificationsService
+}
+
+type service struct {
+	client *Client
+}
+
+// NewClient returns a new ND API client for the ND located at the base URL.
+func NewClient(baseURL string, opts ...Option) (*Client, error) {
+	bUrl, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url '%s': %w", baseURL, err)
+	}
+	if bUrl.Scheme == "" || bUrl.Host == "" {
+		return nil, fmt.Errorf("invalid url '%s': the url must contain a scheme and host", baseURL)
+	}
+
+	c := &Client{
+		baseURL:            bUrl,
+		logger:             discardLogger{},
+		maxRetries:         DefaultMaxRetries,
+		backoffMinDelay:    DefaultBackoffMinDelay,
+		backoffMaxDelay:    DefaultBackoffMaxDelay,
+		backoffDelayFactor: DefaultBackoffDelayFactor,
+		taskPollInterval:   DefaultTaskPollInterval,
+		uploadChunkSize:    DefaultUploadChunkSize,
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{
+			Transport: c.newTransport(),
+			Timeout:   time.Duration(DefaultReqTimeoutVal) * time.Second,
+		}
+	}
+
+	c.Clusters = &ClustersService{client: c}
+	c.Version = &VersionService{client: c}
+	c.Users = &UsersService{client: c}
+	c.Backups = &BackupsService{client: c}
+	c.Tasks = &TasksService{client: c}
+	c.Firmware = &FirmwareService{client: c}
+	c.Services = &ServicesService{client: c}
+	c.Notifications = &NotificationsService{client: c}
+
+	return c, nil
+}
+
+func (c *Client) newTransport() *http.Transport {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			CipherSuites: []uint16{
+				tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+				tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+				tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256,
+				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			},
+			PreferServerCipherSuites: true,
+			InsecureSkipVerify:       c.insecure,
+			MinVersion:               tls.VersionTLS11,
+			MaxVersion:               tls.VersionTLS13,
+		},
+	}
+
+	if c.proxyUrl != nil {
+		c.logger.Printf("[DEBUG] Using Proxy Server: %s", c.proxyUrl.Redacted())
+		transport.Proxy = http.ProxyURL(c.proxyUrl)
+		transport.ProxyConnectHeader = c.proxyHeader
+	}
+	return transport
+}
+
+// BaseURL returns the URL of the ND the client is connected to.
+func (c *Client) BaseURL() *url.URL {
+	u := *c.baseURL
+	return &u
+}
+
+func (c *Client) makeFullUrl(method string, path string) (string, error) {
+	path = strings.TrimLeft(path, "/")
+	path = fmt.Sprintf("/%v", path)
+	url, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	if method == http.MethodPatch {
+		validateString := url.Query()
+		validateString.Set("validate", "false")
+		url.RawQuery = validateString.Encode()
+	}
+	fURL := c.baseURL.ResolveReference(url)
+	return fURL.String(), nil
+}
+
+// NewRequest creates an API request for the path relative to the base URL of the client.
+// The body is sent as JSON, it can be a value that is marshalled to JSON, a json.RawMessage or a []byte.
+func (c *Client) NewRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	fURL, err := c.makeFullUrl(method, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		var payload []byte
+		switch b := body.(type) {
+		case []byte:
+			payload = b
+		case json.RawMessage:
+			payload = b
+		default:
+			payload, err = json.Marshal(body)
+			if err != nil {
+				return nil, fmt.Errorf("marshalling of the request body failed: %w", err)
+			}
+		}
+		bodyReader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fURL, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
+
+// Do sends an authenticated API request and decodes the JSON response into the value pointed to by v.
+// Requests failing with a connection error or a transient status code are retried with an exponential backoff.
+// An *APIError is returned when ND responds with an error status code, the response is returned in all cases
+// where ND was reached. The body of the returned response is already read and closed.
+func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
+	return c.do(req, v, true)
+}
+
+func (c *Client) do(req *http.Request, v interface{}, authenticated bool) (*http.Response, error) {
+	c.logger.Printf("[DEBUG] HTTP request %s %s", req.Method, req.URL.String())
+	reauthenticated := false
+
+	httpClient := c.requestClient(req)
+
+	for attempts := 0; ; attempts++ {
+		// The body of the request is read by the first attempt, it is opened again for the next attempts.
+		if attempts > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		if authenticated {
+			if err := c.injectAuthenticationHeader(req); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			if req.Context().Err() != nil {
+				return nil, req.Context().Err()
+			}
+			if ok := c.backoff(req.Context(), attempts); !ok {
+				c.logger.Printf("[ERROR] HTTP Connection error occured: %+v", err)
+				return nil, fmt.Errorf("failed to connect to ND. Verify that you are connecting to an ND.\nError message: %w", err)
+			}
+			c.logger.Printf("[ERROR] HTTP Connection failed: %s, retries: %v", err, attempts)
+			continue
+		}
+
+		bodyBytes, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return resp, err
+		}
+
+		c.logger.Printf("[DEBUG] HTTP response %s %s: %s", req.Method, req.URL.String(), resp.Status)
+		if !c.skipLoggingPayload && authenticated {
+			c.logger.Printf("[TRACE] HTTP response body %s %s: %s", req.Method, req.URL.String(), string(bodyBytes))
+		}
+
+		// The token can be invalidated by ND before its expiry, ie when ND is restarted, so login once more before failing.
+		// The API key cannot be renewed by the client, so the request is not sent again when the API key is rejected.
+		if authenticated && resp.StatusCode == http.StatusUnauthorized && !reauthenticated && c.apiKey == "" {
+			reauthenticated = true
+			c.invalidateToken()
+			continue
+		}
+
+		if isRetryableStatus(resp.StatusCode) {
+			if ok := c.backoff(req.Context(), attempts); ok {
+				c.logger.Printf("[ERROR] HTTP Request failed: StatusCode %v, Retries: %v", resp.StatusCode, attempts)
+				continue
+			}
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return resp, newAPIError(req, resp, bodyBytes)
+		}
+
+		if v != nil && len(bytes.TrimSpace(bodyBytes)) != 0 {
+			if err := json.Unmarshal(bodyBytes, v); err != nil {
+				return resp, fmt.Errorf("failed to parse JSON response from: %s. Verify that you are connecting to an ND.\nError message: %w", req.URL.String(), err)
+			}
+		}
+		return resp, nil
+	}
+}
+
+// requestClient returns the HTTP client sending the request. The duration of an upload or a download depends on its
+// size, so the requests streaming a body are only limited by their context instead of the timeout of the HTTP client.
+func (c *Client) requestClient(req *http.Request) *http.Client {
+	if !isStreamRequest(req) || c.httpClient.Timeout == 0 {
+		return c.httpClient
+	}
+	streamClient := *c.httpClient
+	streamClient.Timeout = 0
+	return &streamClient
+}
+
+// get sends a GET request for the path and decodes the response into v.
+func (c *Client) get(ctx context.Context, path string, v interface{}) error {
+	return c.send(ctx, http.MethodGet, path, nil, v)
+}
+
+// send sends a request with the body for the path and decodes the response into v.
+func (c *Client) send(ctx context.Context, method, path string, body, v interface{}) error {
+	req, err := c.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	_, err = c.Do(req, v)
+	return err
+}
+
+// LockPath serializes the read-modify-write operations on the object at the API path, ie a list of an object which is
+// edited by several resources. The returned function releases the lock of the path.
+func (c *Client) LockPath(path string) (unlock func()) {
+	value, _ := c.pathLocks.LoadOrStore(path, &sync.Mutex{})
+	mutex := value.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+// The ND nginx responds with these status codes when it is busy or a service is restarting.
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func (c *Client) backoff(ctx context.Context, attempts int) bool {
+	c.logger.Printf("[DEBUG] Begining backoff method: attempts %v on %v", attempts, c.maxRetries)
+	if attempts >= c.maxRetries {
+		c.logger.Printf("[DEBUG] Exit from backoff method with return value false")
+		return false
+	}
+
+	min := float64(c.backoffMinDelay)
+	backoff := min * math.Pow(c.backoffDelayFactor, float64(attempts))
+	if backoff > float64(c.backoffMaxDelay) {
+		backoff = float64(c.backoffMaxDelay)
+	}
+	backoff = (rand.Float64()/2+0.5)*(backoff-min) + min
+	backoffDuration := time.Duration(backoff)
+	c.logger.Printf("[TRACE] Starting sleeping for %v", backoffDuration.Round(time.Second))
+
+	timer := time.NewTimer(backoffDuration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+	}
+	c.logger.Printf("[DEBUG] Exit from backoff method with return value true")
+	return true
+}
+
+var errEmptyIdentifier = errors.New("the identifier of the object cannot be empty")
@@ -0,0 +1,347 @@
+package nd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var TestBaseUrls = [...]string{
+	"https://nd.host.cisco",
+	"https://nd.host.cisco/",
+	"https://nd.host.cisco//",
+	"https://nd.host.cisco/test",
+	"https://nd.host.cisco/test/",
+	"https://nd.host.cisco/test//",
+}
+
+func AssertFullUrl(t *testing.T, baseUrl string, method string, path string, expected string) {
+	url, err := url.Parse(baseUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ndclient := &Client{
+		baseURL: url,
+	}
+
+	actual, err := ndclient.makeFullUrl(method, path)
+	if actual != expected || err != nil {
+		t.Errorf(`makeFullUrl("%s", "%s") = %q, %v, expected %#q`, method, path, actual, err, expected)
+	}
+}
+
+func TestMakeFullUrl_Login(t *testing.T) {
+	expected := "https://nd.host.cisco/login"
+	paths := [...]string{
+		"login",
+		"/login",
+		"///login",
+	}
+	for _, baseUrl := range TestBaseUrls {
+		for _, path := range paths {
+			AssertFullUrl(t, baseUrl, "GET", path, expected)
+		}
+	}
+}
+
+func TestMakeFullUrl_Get(t *testing.T) {
+	expected_nd := "https://nd.host.cisco/nexus/api/sitemanagement/v4/sites"
+	paths := [...]string{
+		"nexus/api/sitemanagement/v4/sites",
+		"/nexus/api/sitemanagement/v4/sites",
+		"///nexus/api/sitemanagement/v4/sites",
+	}
+	for _, baseUrl := range TestBaseUrls {
+		for _, path := range paths {
+			AssertFullUrl(t, baseUrl, "GET", path, expected_nd)
+		}
+	}
+}
+
+func TestMakeFullUrl_Patch(t *testing.T) {
+	expected := "https://nd.host.cisco/nexus/api/sitemanagement/v4/sites?validate=false"
+	path := "/nexus/api/sitemanagement/v4/sites"
+	for _, baseUrl := range TestBaseUrls {
+		AssertFullUrl(t, baseUrl, "PATCH", path, expected)
+	}
+}
+
+func TestMakeFullUrl_PatchExtraQuery(t *testing.T) {
+	expected := "https://nd.host.cisco/nexus/api/sitemanagement/v4/sites?extra=query&validate=false"
+	path := "nexus/api/sitemanagement/v4/sites?extra=query"
+	for _, baseUrl := range TestBaseUrls {
+		AssertFullUrl(t, baseUrl, "PATCH", path, expected)
+	}
+}
+
+// newTestServer returns a ND mock server which accepts the login of the user "admin" and passes the other requests to the handler.
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	var logins atomic.Int64
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			var login loginRequest
+			if err := json.NewDecoder(r.Body).Decode(&login); err != nil || login.UserName != "admin" || login.UserPasswd != "password" {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"errors": ["Invalid credentials"]}`)
+				return
+			}
+			fmt.Fprintf(w, `{"token": "token-%d"}`, logins.Add(1))
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestClient(t *testing.T, server *httptest.Server, opts ...Option) *Client {
+	t.Helper()
+	opts = append([]Option{
+		WithCredentials("admin", "password"),
+		WithHTTPClient(server.Client()),
+		WithBackoff(0, 0, 1),
+		WithPlatformVersion("4.1.1g"),
+	}, opts...)
+	client, err := NewClient(server.URL, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestNewClient_InvalidUrl(t *testing.T) {
+	for _, baseUrl := range []string{"", "nd.host.cisco", "://nd.host.cisco"} {
+		if _, err := NewClient(baseUrl); err == nil {
+			t.Errorf("NewClient(%q) expected an error", baseUrl)
+		}
+	}
+}
+
+func TestDo_AuthenticationHeaders(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-1" || r.Header.Get("Cookie") != "AuthCookie=token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"name": "test"}`)
+	})
+	client := newTestClient(t, server)
+
+	var response map[string]string
+	req, err := client.NewRequest(context.Background(), "GET", "/api/v1/test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req, &response); err != nil {
+		t.Fatal(err)
+	}
+	if response["name"] != "test" {
+		t.Errorf("Do() response = %v, expected name 'test'", response)
+	}
+}
+
+func TestDo_InvalidCredentials(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {})
+	client := newTestClient(t, server, WithCredentials("admin", "wrong"))
+
+	err := client.Login(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Login() error = %v, expected an APIError with status 401", err)
+	}
+	if !strings.Contains(err.Error(), "Invalid credentials") {
+		t.Errorf("Login() error = %v, expected the ND error message", err)
+	}
+}
+
+func TestDo_ReauthenticateOnUnauthorized(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		// The first token is rejected to simulate a token invalidated by ND.
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+	client := newTestClient(t, server)
+
+	if err := client.send(context.Background(), "GET", "/api/v1/test", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if client.authToken.Token != "token-2" {
+		t.Errorf("token = %s, expected token-2", client.authToken.Token)
+	}
+}
+
+func TestDo_APIKeyHeaders(t *testing.T) {
+	var requests atomic.Int64
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("X-Nd-Username") != "automation" || r.Header.Get("X-Nd-Apikey") != "key" || r.Header.Get("Authorization") != "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+	client := newTestClient(t, server, WithAPIKey("automation", "key"))
+
+	if err := client.Login(context.Background()); err != nil {
+		t.Fatalf("Login() error = %v, expected no login with an API key", err)
+	}
+	if err := client.send(context.Background(), "GET", "/api/v1/test", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// A rejected API key is not sent again.
+	client = newTestClient(t, server, WithAPIKey("automation", "wrong"))
+	var apiErr *APIError
+	if err := client.send(context.Background(), "GET", "/api/v1/test", nil, nil); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("send() error = %v, expected an APIError with status 401", err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("requests = %d, expected 2", requests.Load())
+	}
+	if client.authToken != nil {
+		t.Errorf("authToken = %v, expected no login with an API key", client.authToken)
+	}
+
+	if _, err := NewClient(server.URL, WithAPIKey("automation", "")); err == nil {
+		t.Errorf("NewClient() expected an error for an empty API key")
+	}
+}
+
+func TestDo_RetryTransientStatus(t *testing.T) {
+	var attempts atomic.Int64
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body := make([]byte, r.ContentLength)
+		r.Body.Read(body)
+		if string(body) != `{"name":"test"}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	client := newTestClient(t, server, WithMaxRetries(1))
+	err := client.send(context.Background(), "POST", "/api/v1/test", map[string]string{"name": "test"}, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("send() error = %v, expected an APIError with status 503", err)
+	}
+
+	attempts.Store(0)
+	client = newTestClient(t, server, WithMaxRetries(2))
+	if err := client.send(context.Background(), "POST", "/api/v1/test", map[string]string{"name": "test"}, nil); err != nil {
+		t.Fatalf("send() error = %v, expected the request to succeed after retries", err)
+	}
+}
+
+func TestDo_ContextCanceled(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client := newTestClient(t, server, WithMaxRetries(5), WithBackoff(time.Minute, time.Minute, 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := client.send(ctx, "GET", "/api/v1/test", nil, nil); err == nil {
+		t.Fatal("send() expected an error")
+	}
+	if time.Since(start) > 10*time.Second {
+		t.Errorf("send() did not stop the backoff when the context was canceled")
+	}
+}
+
+func TestDo_Errors(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/missing":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors": [{"message": "Object not found"}]}`)
+		case "/api/v1/invalid":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"message": "Invalid payload"}`)
+		case "/api/v1/html":
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `<html><body><h1>An error occurred.</h1><p>Sorry, the page you are looking for is currently unavailable.</p></body></html>`)
+		}
+	})
+	client := newTestClient(t, server)
+
+	tests := []struct {
+		path     string
+		notFound bool
+		message  string
+	}{
+		{"/api/v1/missing", true, "Object not found"},
+		{"/api/v1/invalid", false, "Invalid payload"},
+		{"/api/v1/html", false, "An error occurred. Sorry, the page you are looking for is currently unavailable."},
+	}
+	for _, test := range tests {
+		err := client.get(context.Background(), test.path, nil)
+		if IsNotFound(err) != test.notFound {
+			t.Errorf("IsNotFound(%v) = %v, expected %v", err, !test.notFound, test.notFound)
+		}
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || len(apiErr.Messages) != 1 || apiErr.Messages[0] != test.message {
+			t.Errorf("get(%s) error = %v, expected message %q", test.path, err, test.message)
+		}
+	}
+}
+
+func TestLockPath(t *testing.T) {
+	client, err := NewClient("https://nd.host.cisco")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var active, maxActive int32
+	done := make(chan struct{})
+	for i := 0; i < 10; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			unlock := client.LockPath("/api/v1/infra/clusterConfig/network")
+			defer unlock()
+			current := atomic.AddInt32(&active, 1)
+			if current > atomic.LoadInt32(&maxActive) {
+				atomic.StoreInt32(&maxActive, current)
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&active, -1)
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		<-done
+	}
+	if maxActive != 1 {
+		t.Errorf("expected the operations on the same path to be serialized, got %d concurrent operations", maxActive)
+	}
+
+	// The locks of different paths are independent.
+	unlock := client.LockPath("/api/v1/infra/clusterConfig/network")
+	defer unlock()
+	acquired := make(chan struct{})
+	go func() {
+		client.LockPath("/api/v1/infra/clusterConfig/dns")()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Error("expected the lock of another path to be acquired")
+	}
+}
@@ -0,0 +1,162 @@
+package nd
+
+import (
+	"context"
+	"net/http"
+)
+
+// ClustersService handles the clusters connected to ND in a multi-cluster setup.
+type ClustersService service
+
+// Cluster is a cluster connected to ND.
+type Cluster struct {
+	Spec   ClusterSpec            `json:"spec"`
+	Status map[string]interface{} `json:"status,omitempty"`
+}
+
+// ClusterSpec is the configuration of a cluster.
+type ClusterSpec struct {
+	Name        string              `json:"name,omitempty"`
+	ClusterType string              `json:"clusterType,omitempty"`
+	OnboardUrl  string              `json:"onboardUrl,omitempty"`
+	Credentials *ClusterCredentials `json:"credentials,omitempty"`
+	Location    *ClusterLocation    `json:"location,omitempty"`
+	Aci         *ClusterAci         `json:"aci,omitempty"`
+	Nd          *ClusterNd          `json:"nd,omitempty"`
+}
+
+// ClusterCredentials are the credentials used by ND to connect to the cluster.
+type ClusterCredentials struct {
+	User        string `json:"user,omitempty"`
+	Password    string `json:"password,omitempty"`
+	LoginDomain string `json:"loginDomain,omitempty"`
+}
+
+// ClusterLocation is the geographical location of the cluster.
+type ClusterLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// ClusterAci is the configuration specific to APIC clusters.
+type ClusterAci struct {
+	Name           string          `json:"name,omitempty"`
+	LicenseTier    string          `json:"licenseTier,omitempty"`
+	SecurityDomain string          `json:"securityDomain,omitempty"`
+	VerifyCA       *bool           `json:"verifyCA,omitempty"`
+	Telemetry      *ClusterFeature `json:"telemetry,omitempty"`
+	Orchestration  *ClusterFeature `json:"orchestration,omitempty"`
+}
+
+// ClusterFeature is the status and configuration of a feature enabled on an APIC cluster.
+type ClusterFeature struct {
+	Status            string `json:"status,omitempty"`
+	Network           string `json:"network,omitempty"`
+	Epg               string `json:"epg,omitempty"`
+	StreamingProtocol string `json:"streamingProtocol,omitempty"`
+}
+
+// ClusterNd is the configuration specific to ND clusters.
+type ClusterNd struct {
+	MultiClusterLoginDomainName string `json:"multiClusterLoginDomainName,omitempty"`
+}
+
+// ClusterRemoveOptions are the options used to remove a cluster, the credentials are required for APIC clusters.
+type ClusterRemoveOptions struct {
+	Force       bool                `json:"force,omitempty"`
+	Credentials *ClusterCredentials `json:"credentials,omitempty"`
+}
+
+type clusterList struct {
+	Items []Cluster `json:"items"`
+}
+
+// List returns the clusters connected to ND.
+func (s *ClustersService) List(ctx context.Context) ([]Cluster, error) {
+	list := &clusterList{}
+	path, err := s.client.featurePath(ctx, FeatureClusters)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// Get returns the cluster with the name.
+func (s *ClustersService) Get(ctx context.Context, name string) (*Cluster, error) {
+	if name == "" {
+		return nil, errEmptyIdentifier
+	}
+	cluster := &Cluster{}
+	path, err := s.client.featurePath(ctx, FeatureClusters, name)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, cluster); err != nil {
+		return nil, err
+	}
+	return cluster, nil
+}
+
+// Create connects the cluster to ND.
+func (s *ClustersService) Create(ctx context.Context, spec *ClusterSpec) error {
+	path, err := s.client.featurePath(ctx, FeatureClusters)
+	if err != nil {
+		return err
+	}
+	return s.client.send(ctx, http.MethodPost, path, &Cluster{Spec: *spec}, nil)
+}
+
+// Update changes the configuration of the cluster with the name.
+func (s *ClustersService) Update(ctx context.Context, name string, spec *ClusterSpec) error {
+	if name == "" {
+		return errEmptyIdentifier
+	}
+	path, err := s.client.featurePath(ctx, FeatureClusters, name)
+	if err != nil {
+		return err
+	}
+	return s.client.send(ctx, http.MethodPut, path, &Cluster{Spec: *spec}, nil)
+}
+
+// Remove disconnects the cluster with the name from ND.
+func (s *ClustersService) Remove(ctx context.Context, name string, opts *ClusterRemoveOptions) error {
+	if name == "" {
+		return errEmptyIdentifier
+	}
+	if opts == nil {
+		opts = &ClusterRemoveOptions{}
+	}
+	path, err := s.client.featurePath(ctx, FeatureClusters, name, "remove")
+	if err != nil {
+		return err
+	}
+	return s.client.send(ctx, http.MethodPost, path, opts, nil)
+}
+
+// Resync starts a full resynchronization of the configuration and inventory of the cluster with the name.
+func (s *ClustersService) Resync(ctx context.Context, name string) (*Task, error) {
+	return s.startTask(ctx, name, "resync")
+}
+
+// Refresh starts a refresh of the connectivity and status of the cluster with the name.
+func (s *ClustersService) Refresh(ctx context.Context, name string) (*Task, error) {
+	return s.startTask(ctx, name, "refresh")
+}
+
+func (s *ClustersService) startTask(ctx context.Context, name, operation string) (*Task, error) {
+	if name == "" {
+		return nil, errEmptyIdentifier
+	}
+	path, err := s.client.featurePath(ctx, FeatureClusters, name, operation)
+	if err != nil {
+		return nil, err
+	}
+	task := &Task{}
+	if err = s.client.send(ctx, http.MethodPost, path, nil, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
@@ -0,0 +1,115 @@
+package nd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const clustersPath = "/api/v1/infra/clusters"
+
+func TestClusters(t *testing.T) {
+	clusters := map[string]Cluster{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == clustersPath:
+			list := clusterList{}
+			for _, cluster := range clusters {
+				list.Items = append(list.Items, cluster)
+			}
+			json.NewEncoder(w).Encode(list)
+		case r.Method == "POST" && r.URL.Path == clustersPath:
+			var cluster Cluster
+			json.NewDecoder(r.Body).Decode(&cluster)
+			clusters[cluster.Spec.Name] = cluster
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == "GET":
+			cluster, ok := clusters[r.URL.Path[len(clustersPath)+1:]]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"errors": ["Cluster not found"]}`)
+				return
+			}
+			json.NewEncoder(w).Encode(cluster)
+		case r.Method == "POST" && r.URL.Path == clustersPath+"/test/remove":
+			var opts ClusterRemoveOptions
+			json.NewDecoder(r.Body).Decode(&opts)
+			if !opts.Force || opts.Credentials == nil || opts.Credentials.User != "admin" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			delete(clusters, "test")
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	err := client.Clusters.Create(ctx, &ClusterSpec{
+		Name:        "test",
+		ClusterType: "APIC",
+		OnboardUrl:  "10.0.0.1",
+		Aci:         &ClusterAci{Name: "test", Telemetry: &ClusterFeature{Status: "enabled"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster, err := client.Clusters.Get(ctx, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster.Spec.ClusterType != "APIC" || cluster.Spec.Aci.Telemetry.Status != "enabled" {
+		t.Errorf("Clusters.Get() = %+v, expected the created cluster", cluster.Spec)
+	}
+
+	list, err := client.Clusters.List(ctx)
+	if err != nil || len(list) != 1 {
+		t.Errorf("Clusters.List() = %v, %v, expected one cluster", list, err)
+	}
+
+	err = client.Clusters.Remove(ctx, "test", &ClusterRemoveOptions{Force: true, Credentials: &ClusterCredentials{User: "admin", Password: "password"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Clusters.Get(ctx, "test"); !IsNotFound(err) {
+		t.Errorf("Clusters.Get() error = %v, expected a not found error", err)
+	}
+
+	if _, err := client.Clusters.Get(ctx, ""); err == nil {
+		t.Error("Clusters.Get() with an empty name expected an error")
+	}
+}
+
+func TestClustersResyncRefresh(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == clustersPath+"/test/resync":
+			fmt.Fprint(w, `{"id": "resync1", "type": "clusterResync", "status": "pending"}`)
+		case r.Method == "POST" && r.URL.Path == clustersPath+"/test/refresh":
+			fmt.Fprint(w, `{"id": "refresh1", "type": "clusterRefresh", "status": "running"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	task, err := client.Clusters.Resync(ctx, "test")
+	if err != nil || task.Id != "resync1" || task.Status != TaskStatusPending {
+		t.Errorf("Clusters.Resync() = %+v, %v, expected the resync task", task, err)
+	}
+
+	task, err = client.Clusters.Refresh(ctx, "test")
+	if err != nil || task.Id != "refresh1" || task.Status != TaskStatusRunning {
+		t.Errorf("Clusters.Refresh() = %+v, %v, expected the refresh task", task, err)
+	}
+
+	if _, err = client.Clusters.Resync(ctx, "missing"); !IsNotFound(err) {
+		t.Errorf("Clusters.Resync() error = %v, expected a not found error", err)
+	}
+}
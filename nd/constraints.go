@@ -0,0 +1,101 @@
+package nd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Constraints is a set of version constraints which must all be satisfied, ie ">= 3.2.1e, < 4.2".
+type Constraints []constraint
+
+type constraint struct {
+	operator string
+	version  *Version
+	// upper is the exclusive upper bound of the pessimistic operator "~>".
+	upper *Version
+}
+
+var constraintRegex = regexp.MustCompile(`^(=|!=|>=|<=|>|<|~>)?\s*(\S+)$`)
+
+// ParseConstraints parses a comma separated list of version constraints.
+// The supported operators are "=", "!=", ">", ">=", "<", "<=" and "~>", a version without operator must be equal.
+// The pessimistic operator "~>" allows the right-most number of the version to increase, ie "~> 3.2" allows any
+// 3.x version from 3.2 and "~> 3.2.1" allows any 3.2.x version from 3.2.1.
+func ParseConstraints(constraints string) (Constraints, error) {
+	result := Constraints{}
+	for _, part := range strings.Split(constraints, ",") {
+		matches := constraintRegex.FindStringSubmatch(strings.TrimSpace(part))
+		if matches == nil {
+			return nil, fmt.Errorf("invalid version constraint '%s'", strings.TrimSpace(part))
+		}
+
+		version, err := ParseVersion(matches[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint '%s': %w", strings.TrimSpace(part), err)
+		}
+
+		c := constraint{operator: matches[1], version: version}
+		if c.operator == "" {
+			c.operator = "="
+		}
+		if c.operator == "~>" {
+			c.upper, err = pessimisticUpperBound(matches[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid version constraint '%s': %w", strings.TrimSpace(part), err)
+			}
+		}
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+func pessimisticUpperBound(version string) (*Version, error) {
+	v := MustParseVersion(version)
+	switch strings.Count(strings.TrimSpace(version), ".") {
+	case 1:
+		return &Version{Major: v.Major + 1}, nil
+	case 2:
+		return &Version{Major: v.Major, Minor: v.Minor + 1}, nil
+	}
+	return nil, fmt.Errorf("the pessimistic operator requires a version with a minor number")
+}
+
+// Check reports whether the version satisfies all the constraints.
+func (cs Constraints) Check(version *Version) bool {
+	for _, c := range cs {
+		if !c.check(version) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c constraint) check(version *Version) bool {
+	comparison := version.Compare(c.version)
+	switch c.operator {
+	case "=":
+		return comparison == 0
+	case "!=":
+		return comparison != 0
+	case ">":
+		return comparison > 0
+	case ">=":
+		return comparison >= 0
+	case "<":
+		return comparison < 0
+	case "<=":
+		return comparison <= 0
+	case "~>":
+		return comparison >= 0 && version.Compare(c.upper) < 0
+	}
+	return false
+}
+
+func (cs Constraints) String() string {
+	constraints := make([]string, 0, len(cs))
+	for _, c := range cs {
+		constraints = append(constraints, fmt.Sprintf("%s %s", c.operator, c.version))
+	}
+	return strings.Join(constraints, ", ")
+}
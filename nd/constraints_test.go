@@ -0,0 +1,50 @@
+package nd
+
+import "testing"
+
+func TestConstraintsCheck(t *testing.T) {
+	tests := []struct {
+		constraints string
+		version     string
+		expected    bool
+	}{
+		{"3.2.1e", "3.2.1e", true},
+		{"= 3.2.1e", "3.2.1f", false},
+		{"!= 3.2.1e", "3.2.1f", true},
+		{">= 3.2.1e", "3.2.1e", true},
+		{">= 3.2.1e", "3.2.1d", false},
+		{">= 3.2.1", "3.2.1a", true},
+		{"> 3.2.1", "3.2.1", false},
+		{"> 3.2.1", "3.2.1a", true},
+		{"< 4.1", "4.0.9z", true},
+		{"< 4.1", "4.1.0", false},
+		{"<= 4.1", "4.1.0", true},
+		{">= 3.1, < 4.1", "3.2.2m", true},
+		{">= 3.1, < 4.1", "4.1.1g", false},
+		{">=3.1,<4.1", "3.0.1i", false},
+		{"~> 3.2", "3.9.1a", true},
+		{"~> 3.2", "3.1.1k", false},
+		{"~> 3.2", "4.0.0", false},
+		{"~> 3.2.1e", "3.2.9", true},
+		{"~> 3.2.1e", "3.2.1d", false},
+		{"~> 3.2.1e", "3.3.0", false},
+	}
+	for _, test := range tests {
+		constraints, err := ParseConstraints(test.constraints)
+		if err != nil {
+			t.Errorf("ParseConstraints(%q) error = %v", test.constraints, err)
+			continue
+		}
+		if actual := constraints.Check(MustParseVersion(test.version)); actual != test.expected {
+			t.Errorf("ParseConstraints(%q).Check(%s) = %v, expected %v", test.constraints, test.version, actual, test.expected)
+		}
+	}
+}
+
+func TestParseConstraintsInvalid(t *testing.T) {
+	for _, input := range []string{"", ">=", "=> 3.2", ">= 3.2,", "~> 3", ">= 3.x", ">= 3.2 < 4.1"} {
+		if constraints, err := ParseConstraints(input); err == nil {
+			t.Errorf("ParseConstraints(%q) = %v, expected an error", input, constraints)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Package nd provides a Go client for the Cisco Nexus Dashboard REST API.
+//
+// The client handles authentication, token renewal and retries with backoff, and exposes the ND endpoints through
+// service objects such as Clusters, Version, Users and Backups. The package has no dependency on Terraform and
+// can be used by any Go program that automates Nexus Dashboard.
+//
+// Usage:
+//
+//	client, err := nd.NewClient("https://nd.example.com",
+//		nd.WithCredentials("admin", "password"),
+//		nd.WithLoginDomain("DefaultAuth"),
+//		nd.WithMaxRetries(3),
+//	)
+//	if err != nil {
+//		return err
+//	}
+//
+//	version, err := client.Version.Get(ctx)
+//	if err != nil {
+//		return err
+//	}
+//
+//	cluster, err := client.Clusters.Get(ctx, "cluster-1")
+//	if nd.IsNotFound(err) {
+//		// The cluster does not exist.
+//	}
+//
+// Endpoints that are not covered by a service can be called with NewRequest and Do, which apply the same
+// authentication, retry and error handling as the services.
+package nd
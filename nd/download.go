@@ -0,0 +1,215 @@
+package nd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// PartialDownloadSuffix is appended to the destination path of a download while the download is not complete.
+// A partial file left by an interrupted download is resumed by the next download to the same destination.
+const PartialDownloadSuffix = ".part"
+
+// maxErrorBodySize limits the body of an error response read during a download.
+const maxErrorBodySize = 1 << 20
+
+// Download is a file downloaded from ND.
+type Download struct {
+	// Path is the path of the downloaded file.
+	Path string
+	// Size is the number of bytes of the file.
+	Size int64
+	// Checksum is the hex encoded SHA-256 checksum of the file.
+	Checksum string
+	// Resumed reports whether the download continued a partial file of a previous download.
+	Resumed bool
+}
+
+// DownloadFile streams the response of a GET request for the API path to the file at the destination path, the
+// response is not kept in memory. The response is written to the destination path with the PartialDownloadSuffix until
+// the download is complete, an interrupted download is resumed with a Range request from the received data, both by
+// the retries of the request and by the next download to the same destination. The length of the file is verified
+// against the length announced by ND and the checksum, when not empty, is verified against the hex encoded SHA-256
+// checksum of the file. An error matching ErrChecksumMismatch is returned and the partial file is removed when the
+// checksum differs. The progress function, when not nil, is called with the number of bytes received and the total
+// number of bytes, the total is -1 when ND does not announce the length. The duration of the download is only limited
+// by the context.
+func (c *Client) DownloadFile(ctx context.Context, path, destination, checksum string, progress ProgressFunc) (*Download, error) {
+	if destination == "" {
+		return nil, errEmptyDestination
+	}
+	fURL, err := c.makeFullUrl(http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+
+	partialPath := destination + PartialDownloadSuffix
+	file, err := os.OpenFile(partialPath, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		file.Close()
+		// An empty partial file is not kept when the download fails before any data is received.
+		if info, err := os.Stat(partialPath); err == nil && info.Size() == 0 {
+			os.Remove(partialPath)
+		}
+	}()
+
+	offset, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	download := &Download{Path: destination, Resumed: offset > 0}
+	if download.Resumed {
+		c.logger.Printf("[DEBUG] Resuming the download of %s to %s at offset %d", fURL, destination, offset)
+	}
+
+	restart := func() error {
+		download.Resumed = false
+		offset = 0
+		if err := file.Truncate(0); err != nil {
+			return err
+		}
+		_, err := file.Seek(0, io.SeekStart)
+		return err
+	}
+
+	c.logger.Printf("[DEBUG] HTTP download %s to %s", fURL, destination)
+	reauthenticated, restarted := false, false
+	for attempts := 0; ; attempts++ {
+		req, err := http.NewRequestWithContext(context.WithValue(ctx, streamRequestKey{}, true), http.MethodGet, fURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/octet-stream")
+		if offset > 0 {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+		if err = c.injectAuthenticationHeader(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.requestClient(req).Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if ok := c.backoff(ctx, attempts); !ok {
+				return nil, fmt.Errorf("failed to connect to ND. Verify that you are connecting to an ND.\nError message: %w", err)
+			}
+			c.logger.Printf("[ERROR] HTTP Connection failed: %s, retries: %v", err, attempts)
+			continue
+		}
+		c.logger.Printf("[DEBUG] HTTP response %s %s: %s", req.Method, req.URL.String(), resp.Status)
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+			resp.Body.Close()
+
+			switch {
+			case resp.StatusCode == http.StatusUnauthorized && !reauthenticated && c.apiKey == "":
+				reauthenticated = true
+				c.invalidateToken()
+				continue
+			case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0 && !restarted:
+				// The partial file does not match the file of ND, ie the file was replaced, so the download starts again.
+				restarted = true
+				if err = restart(); err != nil {
+					return nil, err
+				}
+				continue
+			case isRetryableStatus(resp.StatusCode) && c.backoff(ctx, attempts):
+				c.logger.Printf("[ERROR] HTTP Request failed: StatusCode %v, Retries: %v", resp.StatusCode, attempts)
+				continue
+			}
+			return nil, newAPIError(req, resp, body)
+		}
+
+		if offset > 0 && resp.StatusCode != http.StatusPartialContent {
+			// ND ignored the range and sends the whole file.
+			if err = restart(); err != nil {
+				resp.Body.Close()
+				return nil, err
+			}
+		}
+
+		total, err := getDownloadTotal(resp, offset)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+
+		var body io.ReadCloser = resp.Body
+		if progress != nil {
+			start := offset
+			body = newProgressReader(resp.Body, total, func(sent, total int64) { progress(start+sent, total) })
+		}
+		written, err := io.Copy(file, body)
+		resp.Body.Close()
+		offset += written
+		if err == nil && total >= 0 && offset != total {
+			err = fmt.Errorf("received %d of %d bytes: %w", offset, total, io.ErrUnexpectedEOF)
+		}
+		if err != nil {
+			// The download continues from the received data when the connection is interrupted.
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if ok := c.backoff(ctx, attempts); !ok {
+				return nil, fmt.Errorf("the download of %s is incomplete, it is resumed by the next download: %w", fURL, err)
+			}
+			c.logger.Printf("[ERROR] HTTP download interrupted at offset %d: %s, retries: %v", offset, err, attempts)
+			continue
+		}
+		break
+	}
+
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return nil, fmt.Errorf("unable to compute the checksum of %s: %w", partialPath, err)
+	}
+	download.Size = offset
+	download.Checksum = hex.EncodeToString(hash.Sum(nil))
+	if checksum != "" && !strings.EqualFold(checksum, download.Checksum) {
+		// The corrupted file is not resumed by the next download.
+		file.Close()
+		os.Remove(partialPath)
+		return nil, fmt.Errorf("%w: the checksum of the download of %s is %s, expected %s", ErrChecksumMismatch, fURL, download.Checksum, checksum)
+	}
+
+	if err = file.Close(); err != nil {
+		return nil, err
+	}
+	if err = os.Rename(partialPath, destination); err != nil {
+		return nil, err
+	}
+	return download, nil
+}
+
+// getDownloadTotal returns the total number of bytes of the downloaded file, -1 when the length is not announced.
+func getDownloadTotal(resp *http.Response, offset int64) (int64, error) {
+	if resp.StatusCode == http.StatusPartialContent {
+		var start, end, total int64
+		contentRange := resp.Header.Get("Content-Range")
+		if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/%d", &start, &end, &total); err != nil || start != offset {
+			return 0, fmt.Errorf("ND responded with the range '%s' to the download from offset %d", contentRange, offset)
+		}
+		return total, nil
+	}
+	if resp.ContentLength < 0 {
+		return -1, nil
+	}
+	return offset + resp.ContentLength, nil
+}
+
+var errEmptyDestination = errors.New("the destination of the download cannot be empty")
@@ -0,0 +1,184 @@
+package nd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const downloadContent = "0123456789"
+
+// newTestDownloadServer serves the download content with Range support and records the Range headers of the requests.
+func newTestDownloadServer(t *testing.T, ranges *[]string) *Client {
+	t.Helper()
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		*ranges = append(*ranges, r.Header.Get("Range"))
+		http.ServeContent(w, r, "backup.tgz", time.Time{}, strings.NewReader(downloadContent))
+	})
+	return newTestClient(t, server)
+}
+
+func downloadChecksum() string {
+	checksum := sha256.Sum256([]byte(downloadContent))
+	return hex.EncodeToString(checksum[:])
+}
+
+func assertDownloadedFile(t *testing.T, destination string) {
+	t.Helper()
+	content, err := os.ReadFile(destination)
+	if err != nil || string(content) != downloadContent {
+		t.Errorf("downloaded file content = %q, %v, expected %q", content, err, downloadContent)
+	}
+	if _, err = os.Stat(destination + PartialDownloadSuffix); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected the partial file to be removed, got %v", err)
+	}
+}
+
+func TestDownloadFile(t *testing.T) {
+	ranges := []string{}
+	client := newTestDownloadServer(t, &ranges)
+	destination := filepath.Join(t.TempDir(), "backup.tgz")
+
+	progress := []int64{}
+	download, err := client.DownloadFile(context.Background(), "/api/v1/files/backup.tgz", destination, strings.ToUpper(downloadChecksum()), func(sent, total int64) {
+		if total != 10 {
+			t.Errorf("progress total = %d, expected 10", total)
+		}
+		progress = append(progress, sent)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if download.Path != destination || download.Size != 10 || download.Checksum != downloadChecksum() || download.Resumed {
+		t.Errorf("DownloadFile() = %+v, expected the downloaded file", download)
+	}
+	if len(progress) == 0 || progress[len(progress)-1] != 10 {
+		t.Errorf("progress = %v, expected the progress to end at 10", progress)
+	}
+	if len(ranges) != 1 || ranges[0] != "" {
+		t.Errorf("ranges = %q, expected a single request without range", ranges)
+	}
+	assertDownloadedFile(t, destination)
+}
+
+func TestDownloadFileResume(t *testing.T) {
+	ranges := []string{}
+	client := newTestDownloadServer(t, &ranges)
+	destination := filepath.Join(t.TempDir(), "backup.tgz")
+	if err := os.WriteFile(destination+PartialDownloadSuffix, []byte("01234"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	download, err := client.DownloadFile(context.Background(), "/api/v1/files/backup.tgz", destination, downloadChecksum(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !download.Resumed || download.Size != 10 {
+		t.Errorf("DownloadFile() = %+v, expected a resumed download", download)
+	}
+	if len(ranges) != 1 || ranges[0] != "bytes=5-" {
+		t.Errorf("ranges = %q, expected a request from the end of the partial file", ranges)
+	}
+	assertDownloadedFile(t, destination)
+}
+
+func TestDownloadFileInterrupted(t *testing.T) {
+	ranges := []string{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		if len(ranges) == 1 {
+			// The connection is closed after the first bytes, before the announced length is sent.
+			w.Header().Set("Content-Length", "10")
+			io.WriteString(w, downloadContent[:4])
+			w.(http.Flusher).Flush()
+			panic(http.ErrAbortHandler)
+		}
+		http.ServeContent(w, r, "backup.tgz", time.Time{}, strings.NewReader(downloadContent))
+	})
+	client := newTestClient(t, server, WithMaxRetries(1))
+	destination := filepath.Join(t.TempDir(), "backup.tgz")
+
+	download, err := client.DownloadFile(context.Background(), "/api/v1/files/backup.tgz", destination, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if download.Checksum != downloadChecksum() {
+		t.Errorf("DownloadFile() = %+v, expected the checksum %s", download, downloadChecksum())
+	}
+	if strings.Join(ranges, ",") != ",bytes=4-" {
+		t.Errorf("ranges = %q, expected the retry to continue from the received bytes", ranges)
+	}
+	assertDownloadedFile(t, destination)
+
+	// The partial file is kept for the next download when the retries are exhausted.
+	ranges = []string{}
+	client = newTestClient(t, server, WithMaxRetries(0))
+	if _, err = client.DownloadFile(context.Background(), "/api/v1/files/backup.tgz", destination, "", nil); err == nil || !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("DownloadFile() error = %v, expected an incomplete download", err)
+	}
+	if content, _ := os.ReadFile(destination + PartialDownloadSuffix); string(content) != downloadContent[:4] {
+		t.Errorf("partial file content = %q, expected the received bytes", content)
+	}
+}
+
+func TestDownloadFileRangeIgnored(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, downloadContent)
+	})
+	client := newTestClient(t, server)
+	destination := filepath.Join(t.TempDir(), "backup.tgz")
+	if err := os.WriteFile(destination+PartialDownloadSuffix, []byte("abc"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	download, err := client.DownloadFile(context.Background(), "/api/v1/files/backup.tgz", destination, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if download.Resumed || download.Size != 10 {
+		t.Errorf("DownloadFile() = %+v, expected the whole file", download)
+	}
+	assertDownloadedFile(t, destination)
+}
+
+func TestDownloadFileErrors(t *testing.T) {
+	ranges := []string{}
+	client := newTestDownloadServer(t, &ranges)
+	destination := filepath.Join(t.TempDir(), "backup.tgz")
+
+	// The corrupted file is removed instead of being resumed by the next download.
+	_, err := client.DownloadFile(context.Background(), "/api/v1/files/backup.tgz", destination, strings.Repeat("0", 64), nil)
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("DownloadFile() error = %v, expected a checksum mismatch", err)
+	}
+	for _, path := range []string{destination, destination + PartialDownloadSuffix} {
+		if _, err = os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("expected %s to be removed, got %v", path, err)
+		}
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"errors": ["Backup not found"]}`)
+	})
+	client = newTestClient(t, server)
+	_, err = client.DownloadFile(context.Background(), "/api/v1/files/backup.tgz", destination, "", nil)
+	if !IsNotFound(err) || !strings.Contains(err.Error(), "Backup not found") {
+		t.Errorf("DownloadFile() error = %v, expected a not found error", err)
+	}
+	if _, err = os.Stat(destination + PartialDownloadSuffix); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected no partial file, got %v", err)
+	}
+
+	if _, err = client.DownloadFile(context.Background(), "/api/v1/files/backup.tgz", "", "", nil); !errors.Is(err, errEmptyDestination) {
+		t.Errorf("DownloadFile() error = %v, expected an empty destination error", err)
+	}
+}
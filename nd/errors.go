@@ -0,0 +1,162 @@
+package nd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// ErrNotFound is matched by errors.Is for the errors of requests that failed because the object does not exist.
+var ErrNotFound = errors.New("object not found")
+
+// ErrChecksumMismatch is matched by errors.Is for the errors of transfers where the checksum of the transferred file
+// differs from the expected checksum.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// IsNotFound reports whether the error is caused by an object that does not exist in ND.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// APIError is returned when ND responds to a request with an error status code.
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Status     string
+	// Messages contains the error messages returned by ND.
+	Messages []string
+	// Body is the raw body of the response.
+	Body []byte
+}
+
+func (e *APIError) Error() string {
+	message := strings.Join(e.Messages, "; ")
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("the %s %s request failed with status %d: %s", e.Method, e.URL, e.StatusCode, message)
+}
+
+// Is allows errors.Is to match the error of a request that returned the status code 404 with ErrNotFound.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+func newAPIError(req *http.Request, resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       body,
+	}
+
+	var response interface{}
+	if err := json.Unmarshal(body, &response); err == nil {
+		apiErr.Messages = getErrorMessages(response)
+	} else if len(strings.TrimSpace(string(body))) != 0 {
+		// If nginx is too busy or the page is not found, ND's nginx will response with an HTML doc instead of a JSON Response.
+		// In those cases, parse the HTML response for the message and return that to the user
+		apiErr.Messages = []string{parseHtmlResp(string(body))}
+	}
+	return apiErr
+}
+
+// ND returns the error messages in different formats depending on the API, ie:
+// {"errors": ["message"]}, {"errors": [{"message": "message"}]}, {"message": "message"} or {"error": "message"}.
+func getErrorMessages(response interface{}) []string {
+	responseMap, ok := response.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	messages := []string{}
+	for _, key := range []string{"errors", "error", "messages", "message", "description"} {
+		switch value := responseMap[key].(type) {
+		case string:
+			if value != "" {
+				messages = append(messages, value)
+			}
+		case []interface{}:
+			for _, item := range value {
+				switch itemValue := item.(type) {
+				case string:
+					messages = append(messages, itemValue)
+				case map[string]interface{}:
+					messages = append(messages, getErrorMessages(itemValue)...)
+				}
+			}
+		case map[string]interface{}:
+			messages = append(messages, getErrorMessages(value)...)
+		}
+		if len(messages) != 0 {
+			return messages
+		}
+	}
+	return messages
+}
+
+// Sample Response Body: https://github.com/nginx/nginx-releases/blob/master/html/50x.html
+// <!DOCTYPE html>
+// <html>
+// <head>
+// <title>Error</title>
+// <style>
+//
+//	body {
+//	    width: 35em;
+//	    margin: 0 auto;
+//	    font-family: Tahoma, Verdana, Arial, sans-serif;
+//	}
+//
+// </style>
+// </head>
+// <body>
+// <h1>An error occurred.</h1>
+// <p>Sorry, the page you are looking for is currently unavailable.<br/>
+// Please try again later.</p>
+// <p>If you are the system administrator of this resource then you should check
+// the <a href="http://nginx.org/r/error_log">error log</a> for details.</p>
+// <p><em>Faithfully yours, nginx.</em></p>
+// </body>
+// </html>
+//
+// Sample return message:
+// An error occurred. Sorry, the page you are looking for is currently unavailable. If you are the system administrator of this
+// resource then you should check the error log for details. Faithfully yours, nginx.
+func parseHtmlResp(body string) string {
+	reader := strings.NewReader(body)
+	tokenizer := html.NewTokenizer(reader)
+	errStr := ""
+	prevTag := ""
+	for {
+		tt := tokenizer.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		tag, _ := tokenizer.TagName()
+		token := tokenizer.Token()
+
+		if prevTag == "a" || prevTag == "p" || prevTag == "body" || prevTag == "h1" {
+			data := strings.TrimSpace(token.Data)
+			if data == "" {
+				continue
+			}
+			if errStr == "" {
+				errStr = data
+			} else {
+				errStr = errStr + " " + data
+			}
+		}
+		prevTag = string(tag)
+	}
+	if errStr == "" {
+		errStr = "Empty ND HTML Response"
+	}
+	return errStr
+}
@@ -0,0 +1,386 @@
+package nd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultUploadChunkSize is the size of the chunks of a firmware image upload.
+const DefaultUploadChunkSize int64 = 16 << 20
+
+// maxUploadResyncs is the number of times the offset of an upload is requested from ND when a chunk is rejected
+// because ND received another offset, ie when a previous response was lost.
+const maxUploadResyncs = 3
+
+// The statuses of a cluster upgrade.
+const (
+	UpgradeStatusIdle      = "idle"
+	UpgradeStatusRunning   = "running"
+	UpgradeStatusCompleted = "completed"
+	UpgradeStatusFailed    = "failed"
+)
+
+// FirmwareService handles the firmware images of ND and the upgrade of the ND cluster.
+type FirmwareService service
+
+// FirmwareImage is a firmware image stored in the image repository of ND.
+type FirmwareImage struct {
+	Name       string `json:"name"`
+	Version    string `json:"version,omitempty"`
+	Size       int64  `json:"size,omitempty"`
+	Checksum   string `json:"sha256,omitempty"`
+	Url        string `json:"url,omitempty"`
+	Status     string `json:"status,omitempty"`
+	UploadedAt string `json:"uploadedAt,omitempty"`
+}
+
+type firmwareImageList struct {
+	Items []FirmwareImage `json:"items"`
+}
+
+// FirmwareUpload is an upload session of a firmware image, the Offset is the number of bytes received by ND.
+type FirmwareUpload struct {
+	Id       string `json:"id,omitempty"`
+	FileName string `json:"fileName"`
+	Size     int64  `json:"size"`
+	Checksum string `json:"sha256"`
+	Offset   int64  `json:"offset,omitempty"`
+}
+
+type firmwareUploadList struct {
+	Items []FirmwareUpload `json:"items"`
+}
+
+// Upgrade is the status of the upgrade of the ND cluster.
+type Upgrade struct {
+	Image          string        `json:"image,omitempty"`
+	TargetVersion  string        `json:"targetVersion,omitempty"`
+	CurrentVersion string        `json:"currentVersion,omitempty"`
+	Status         string        `json:"status,omitempty"`
+	Message        string        `json:"message,omitempty"`
+	Nodes          []UpgradeNode `json:"nodes,omitempty"`
+}
+
+// UpgradeNode is the upgrade status of a node of the ND cluster.
+type UpgradeNode struct {
+	Name     string `json:"name"`
+	Version  string `json:"version,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Progress int    `json:"progress,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// Done reports whether the upgrade is finished, successfully or not.
+func (u *Upgrade) Done() bool {
+	switch strings.ToLower(u.Status) {
+	case UpgradeStatusCompleted, UpgradeStatusFailed:
+		return true
+	}
+	return false
+}
+
+// Err returns an *UpgradeError when the upgrade failed and nil otherwise.
+func (u *Upgrade) Err() error {
+	if strings.ToLower(u.Status) == UpgradeStatusFailed {
+		return &UpgradeError{Upgrade: u}
+	}
+	return nil
+}
+
+// String returns the progress of the upgrade and its nodes, ie "running: node1 completed (100%), node2 running (40%)".
+func (u *Upgrade) String() string {
+	status := u.Status
+	if status == "" {
+		status = UpgradeStatusIdle
+	}
+	if u.Message != "" {
+		status = fmt.Sprintf("%s: %s", status, u.Message)
+	}
+	nodes := make([]string, 0, len(u.Nodes))
+	for _, node := range u.Nodes {
+		nodes = append(nodes, node.String())
+	}
+	if len(nodes) != 0 {
+		status = fmt.Sprintf("%s [%s]", status, strings.Join(nodes, ", "))
+	}
+	return status
+}
+
+// String returns the progress of the node, ie "node1 running (40%): Installing the image".
+func (n *UpgradeNode) String() string {
+	status := fmt.Sprintf("%s %s", n.Name, n.Status)
+	if n.Progress > 0 {
+		status = fmt.Sprintf("%s (%d%%)", status, n.Progress)
+	}
+	if n.Message != "" {
+		status = fmt.Sprintf("%s: %s", status, n.Message)
+	}
+	return status
+}
+
+// UpgradeError is returned when the upgrade of the ND cluster failed, the error lists the nodes which failed.
+type UpgradeError struct {
+	Upgrade *Upgrade
+}
+
+func (e *UpgradeError) Error() string {
+	message := fmt.Sprintf("the upgrade to %s failed", e.Upgrade.TargetVersion)
+	if e.Upgrade.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, e.Upgrade.Message)
+	}
+	failed := []string{}
+	for _, node := range e.Upgrade.Nodes {
+		if strings.ToLower(node.Status) == UpgradeStatusFailed {
+			failed = append(failed, node.String())
+		}
+	}
+	if len(failed) != 0 {
+		message = fmt.Sprintf("%s (%s)", message, strings.Join(failed, "; "))
+	}
+	return message
+}
+
+// ListImages returns the firmware images of ND.
+func (s *FirmwareService) ListImages(ctx context.Context) ([]FirmwareImage, error) {
+	list := &firmwareImageList{}
+	path, err := s.client.featurePath(ctx, FeatureFirmwareImages)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// GetImage returns the firmware image with the name.
+func (s *FirmwareService) GetImage(ctx context.Context, name string) (*FirmwareImage, error) {
+	if name == "" {
+		return nil, errEmptyIdentifier
+	}
+	image := &FirmwareImage{}
+	path, err := s.client.featurePath(ctx, FeatureFirmwareImages, name)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, image); err != nil {
+		return nil, err
+	}
+	return image, nil
+}
+
+// DeleteImage deletes the firmware image with the name.
+func (s *FirmwareService) DeleteImage(ctx context.Context, name string) error {
+	if name == "" {
+		return errEmptyIdentifier
+	}
+	path, err := s.client.featurePath(ctx, FeatureFirmwareImages, name)
+	if err != nil {
+		return err
+	}
+	return s.client.send(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// ImportImage starts the download of the firmware image at the URL by ND and returns the task of the download.
+// The checksum is the hex encoded SHA-256 checksum of the image, ND verifies the image when it is provided.
+func (s *FirmwareService) ImportImage(ctx context.Context, url, checksum string) (*Task, error) {
+	path, err := s.client.featurePath(ctx, FeatureFirmwareImages)
+	if err != nil {
+		return nil, err
+	}
+	task := &Task{}
+	body := map[string]string{"url": url}
+	if checksum != "" {
+		body["sha256"] = strings.ToLower(checksum)
+	}
+	if err = s.client.send(ctx, http.MethodPost, path, body, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// UploadImageFile uploads the firmware image file at the path, see UploadImage. The expected checksum is optional,
+// an error matching ErrChecksumMismatch is returned before the upload when the checksum of the file differs.
+func (s *FirmwareService) UploadImageFile(ctx context.Context, path, checksum string, progress ProgressFunc) (*FirmwareImage, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return nil, fmt.Errorf("unable to compute the checksum of %s: %w", path, err)
+	}
+	fileChecksum := hex.EncodeToString(hash.Sum(nil))
+	if checksum != "" && !strings.EqualFold(checksum, fileChecksum) {
+		return nil, fmt.Errorf("%w: the checksum of %s is %s, expected %s", ErrChecksumMismatch, path, fileChecksum, checksum)
+	}
+
+	return s.UploadImage(ctx, filepath.Base(path), file, info.Size(), fileChecksum, progress)
+}
+
+// UploadImage uploads the firmware image in chunks and returns the image stored by ND.
+// The checksum is the hex encoded SHA-256 checksum of the image. An unfinished upload of the same file, with the same
+// name, size and checksum, is resumed from the offset received by ND. An error matching ErrChecksumMismatch is returned
+// when the checksum of the image stored by ND differs from the checksum. The progress function, when not nil, is called
+// with the number of bytes of the image received by ND while the chunks are sent.
+func (s *FirmwareService) UploadImage(ctx context.Context, fileName string, source io.ReaderAt, size int64, checksum string, progress ProgressFunc) (*FirmwareImage, error) {
+	path, err := s.client.featurePath(ctx, FeatureFirmwareUploads)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := s.findUpload(ctx, path, fileName, size, checksum)
+	if err != nil {
+		return nil, err
+	}
+	if upload != nil {
+		s.client.logger.Printf("[DEBUG] Resuming the upload %s of %s at offset %d", upload.Id, fileName, upload.Offset)
+	} else {
+		upload = &FirmwareUpload{}
+		if err = s.client.send(ctx, http.MethodPost, path, &FirmwareUpload{FileName: fileName, Size: size, Checksum: checksum}, upload); err != nil {
+			return nil, err
+		}
+		if upload.Id == "" {
+			return nil, fmt.Errorf("ND did not return the ID of the upload of %s", fileName)
+		}
+	}
+	uploadPath := fmt.Sprintf("%s/%s", path, upload.Id)
+
+	offset := upload.Offset
+	for resyncs := 0; offset < size; {
+		length := min(s.client.uploadChunkSize, size-offset)
+		var chunkProgress ProgressFunc
+		if progress != nil {
+			chunkOffset := offset
+			chunkProgress = func(sent, _ int64) { progress(chunkOffset+sent, size) }
+		}
+
+		req, err := s.client.NewStreamRequest(ctx, http.MethodPut, uploadPath, "application/octet-stream", NewSectionSource(fileName, source, offset, length), chunkProgress)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, size))
+
+		received := &FirmwareUpload{}
+		_, err = s.client.Do(req, received)
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict && resyncs < maxUploadResyncs {
+			// ND expects another offset, the upload continues at the offset received by ND.
+			resyncs++
+			if err = s.client.get(ctx, uploadPath, received); err != nil {
+				return nil, err
+			}
+			s.client.logger.Printf("[DEBUG] Upload %s of %s continues at offset %d instead of %d", upload.Id, fileName, received.Offset, offset)
+			offset = received.Offset
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("upload of %s failed at offset %d: %w", fileName, offset, err)
+		}
+
+		offset += length
+		if received.Offset > 0 {
+			offset = received.Offset
+		}
+	}
+
+	image := &FirmwareImage{}
+	if err = s.client.send(ctx, http.MethodPost, fmt.Sprintf("%s/complete", uploadPath), nil, image); err != nil {
+		return nil, err
+	}
+	if image.Checksum != "" && !strings.EqualFold(image.Checksum, checksum) {
+		return image, fmt.Errorf("%w: the checksum of the image %s stored by ND is %s, expected %s", ErrChecksumMismatch, image.Name, image.Checksum, checksum)
+	}
+	return image, nil
+}
+
+// findUpload returns the unfinished upload of the file or nil when there is no upload to resume.
+func (s *FirmwareService) findUpload(ctx context.Context, path, fileName string, size int64, checksum string) (*FirmwareUpload, error) {
+	list := &firmwareUploadList{}
+	if err := s.client.get(ctx, path, list); err != nil {
+		return nil, err
+	}
+	for _, upload := range list.Items {
+		if upload.FileName == fileName && upload.Size == size && strings.EqualFold(upload.Checksum, checksum) && upload.Id != "" {
+			return &upload, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetUpgrade returns the status of the upgrade of the ND cluster.
+func (s *FirmwareService) GetUpgrade(ctx context.Context) (*Upgrade, error) {
+	upgrade := &Upgrade{}
+	path, err := s.client.featurePath(ctx, FeatureClusterUpgrade)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, upgrade); err != nil {
+		return nil, err
+	}
+	return upgrade, nil
+}
+
+// StartUpgrade starts the upgrade of the ND cluster to the version of the firmware image with the name.
+func (s *FirmwareService) StartUpgrade(ctx context.Context, image string) error {
+	if image == "" {
+		return errEmptyIdentifier
+	}
+	path, err := s.client.featurePath(ctx, FeatureClusterUpgrade)
+	if err != nil {
+		return err
+	}
+	return s.client.send(ctx, http.MethodPost, path, &Upgrade{Image: image}, nil)
+}
+
+// WaitUpgrade polls the status of the upgrade of the ND cluster until it is done or the context is cancelled.
+// The progress function, when not nil, is called with the first status of the upgrade and every time the status of
+// the upgrade or of a node changes. The services of ND restart during the upgrade, so connection errors and transient
+// status codes are ignored until the context is cancelled. An *UpgradeError is returned when the upgrade failed.
+func (s *FirmwareService) WaitUpgrade(ctx context.Context, progress func(*Upgrade)) (*Upgrade, error) {
+	var previous *Upgrade
+	for {
+		upgrade, err := s.GetUpgrade(ctx)
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && !isRetryableStatus(apiErr.StatusCode) {
+			return previous, err
+		} else if err != nil {
+			if ctx.Err() != nil {
+				return previous, fmt.Errorf("waiting for the upgrade: %w", err)
+			}
+			s.client.logger.Printf("[DEBUG] Unable to request the status of the upgrade, ND might be restarting: %s", err)
+		} else {
+			if progress != nil && (previous == nil || upgrade.String() != previous.String()) {
+				progress(upgrade)
+			}
+			previous = upgrade
+
+			if upgrade.Done() {
+				return upgrade, upgrade.Err()
+			}
+		}
+
+		timer := time.NewTimer(s.client.taskPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return previous, fmt.Errorf("waiting for the upgrade: %w", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
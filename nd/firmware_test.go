@@ -0,0 +1,199 @@
+package nd
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const firmwareUploadsPath = "/api/v1/infra/firmware/uploads"
+
+// testUploadServer is an ND upload endpoint storing the received chunks of the uploads in memory.
+type testUploadServer struct {
+	uploads  map[string]*FirmwareUpload
+	data     map[string]*bytes.Buffer
+	requests []string
+	// conflicts is the number of chunks rejected with a conflict before they are accepted.
+	conflicts int
+}
+
+func (s *testUploadServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.requests = append(s.requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, r.Header.Get("Content-Range")))
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, firmwareUploadsPath), "/")
+	switch {
+	case r.Method == "GET" && id == "":
+		list := firmwareUploadList{}
+		for _, upload := range s.uploads {
+			list.Items = append(list.Items, *upload)
+		}
+		json.NewEncoder(w).Encode(list)
+	case r.Method == "POST" && id == "":
+		upload := &FirmwareUpload{}
+		json.NewDecoder(r.Body).Decode(upload)
+		upload.Id = fmt.Sprintf("upload%d", len(s.uploads)+1)
+		s.uploads[upload.Id] = upload
+		s.data[upload.Id] = &bytes.Buffer{}
+		json.NewEncoder(w).Encode(upload)
+	case r.Method == "GET":
+		json.NewEncoder(w).Encode(s.uploads[id])
+	case r.Method == "PUT":
+		upload := s.uploads[id]
+		var start, end, size int64
+		fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &size)
+		if start != upload.Offset || s.conflicts > 0 {
+			s.conflicts--
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, `{"errors": ["Expected offset %d"]}`, upload.Offset)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		s.data[id].Write(body)
+		upload.Offset += int64(len(body))
+		json.NewEncoder(w).Encode(upload)
+	case r.Method == "POST" && strings.HasSuffix(id, "/complete"):
+		id = strings.TrimSuffix(id, "/complete")
+		checksum := sha256.Sum256(s.data[id].Bytes())
+		json.NewEncoder(w).Encode(FirmwareImage{Name: s.uploads[id].FileName, Version: "4.1.2a", Size: int64(s.data[id].Len()), Checksum: hex.EncodeToString(checksum[:])})
+		delete(s.uploads, id)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestFirmwareFile(t *testing.T, content string) (string, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "nd-dk9.4.1.2a.iso")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	checksum := sha256.Sum256([]byte(content))
+	return path, hex.EncodeToString(checksum[:])
+}
+
+func TestFirmwareUploadImageFile(t *testing.T) {
+	uploads := &testUploadServer{uploads: map[string]*FirmwareUpload{}, data: map[string]*bytes.Buffer{}}
+	client := newTestClient(t, newTestServer(t, uploads.handle), WithUploadChunkSize(4))
+	path, checksum := newTestFirmwareFile(t, "0123456789")
+
+	progress := []int64{}
+	image, err := client.Firmware.UploadImageFile(context.Background(), path, strings.ToUpper(checksum), func(sent, total int64) {
+		progress = append(progress, sent)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image.Name != "nd-dk9.4.1.2a.iso" || image.Size != 10 || image.Checksum != checksum {
+		t.Errorf("Firmware.UploadImageFile() = %+v, expected the uploaded image", image)
+	}
+	// The progress of the chunks is reported as the progress of the image.
+	if fmt.Sprint(progress) != "[4 8 10]" {
+		t.Errorf("progress = %v, expected [4 8 10]", progress)
+	}
+	expected := []string{
+		"GET /api/v1/infra/firmware/uploads ",
+		"POST /api/v1/infra/firmware/uploads ",
+		"PUT /api/v1/infra/firmware/uploads/upload1 bytes 0-3/10",
+		"PUT /api/v1/infra/firmware/uploads/upload1 bytes 4-7/10",
+		"PUT /api/v1/infra/firmware/uploads/upload1 bytes 8-9/10",
+		"POST /api/v1/infra/firmware/uploads/upload1/complete ",
+	}
+	if strings.Join(uploads.requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("requests = %q, expected %q", uploads.requests, expected)
+	}
+}
+
+func TestFirmwareUploadImageResume(t *testing.T) {
+	uploads := &testUploadServer{uploads: map[string]*FirmwareUpload{}, data: map[string]*bytes.Buffer{}}
+	client := newTestClient(t, newTestServer(t, uploads.handle), WithUploadChunkSize(4))
+	path, checksum := newTestFirmwareFile(t, "0123456789")
+
+	// The first chunk of a previous upload was received by ND, a second chunk is rejected because ND received it.
+	uploads.uploads["upload1"] = &FirmwareUpload{Id: "upload1", FileName: "nd-dk9.4.1.2a.iso", Size: 10, Checksum: checksum, Offset: 4}
+	uploads.data["upload1"] = bytes.NewBufferString("0123")
+	uploads.conflicts = 1
+
+	image, err := client.Firmware.UploadImageFile(context.Background(), path, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image.Checksum != checksum {
+		t.Errorf("Firmware.UploadImageFile() = %+v, expected the checksum %s", image, checksum)
+	}
+	expected := []string{
+		"GET /api/v1/infra/firmware/uploads ",
+		"PUT /api/v1/infra/firmware/uploads/upload1 bytes 4-7/10",
+		"GET /api/v1/infra/firmware/uploads/upload1 ",
+		"PUT /api/v1/infra/firmware/uploads/upload1 bytes 4-7/10",
+		"PUT /api/v1/infra/firmware/uploads/upload1 bytes 8-9/10",
+		"POST /api/v1/infra/firmware/uploads/upload1/complete ",
+	}
+	if strings.Join(uploads.requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("requests = %q, expected %q", uploads.requests, expected)
+	}
+}
+
+func TestFirmwareUploadImageChecksumMismatch(t *testing.T) {
+	uploads := &testUploadServer{uploads: map[string]*FirmwareUpload{}, data: map[string]*bytes.Buffer{}}
+	client := newTestClient(t, newTestServer(t, uploads.handle))
+	path, _ := newTestFirmwareFile(t, "0123456789")
+
+	_, err := client.Firmware.UploadImageFile(context.Background(), path, strings.Repeat("0", 64), nil)
+	if !errors.Is(err, ErrChecksumMismatch) || len(uploads.requests) != 0 {
+		t.Errorf("Firmware.UploadImageFile() error = %v, expected a checksum mismatch before the upload", err)
+	}
+
+	// ND stores another content than the content sent by the client.
+	_, err = client.Firmware.UploadImage(context.Background(), "nd.iso", strings.NewReader("0123456789"), 10, strings.Repeat("0", 64), nil)
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("Firmware.UploadImage() error = %v, expected a checksum mismatch after the upload", err)
+	}
+}
+
+func TestFirmwareWaitUpgrade(t *testing.T) {
+	statuses := []string{
+		`{"status": "running", "targetVersion": "4.1.2a", "nodes": [{"name": "nd1", "status": "running", "progress": 40}, {"name": "nd2", "status": "pending"}]}`,
+		"",
+		`{"status": "running", "targetVersion": "4.1.2a", "nodes": [{"name": "nd1", "status": "completed", "progress": 100}, {"name": "nd2", "status": "running", "progress": 10}]}`,
+		`{"status": "failed", "targetVersion": "4.1.2a", "message": "Upgrade aborted", "nodes": [{"name": "nd1", "status": "completed", "progress": 100}, {"name": "nd2", "status": "failed", "message": "Disk full"}]}`,
+	}
+	var requests atomic.Int64
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[min(int(requests.Add(1))-1, len(statuses)-1)]
+		if status == "" {
+			// ND is restarting during the upgrade.
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, status)
+	})
+	client := newTestClient(t, server, WithTaskPollInterval(time.Millisecond), WithMaxRetries(0))
+
+	messages := []string{}
+	_, err := client.Firmware.WaitUpgrade(context.Background(), func(upgrade *Upgrade) {
+		messages = append(messages, upgrade.String())
+	})
+	var upgradeErr *UpgradeError
+	if !errors.As(err, &upgradeErr) || err.Error() != "the upgrade to 4.1.2a failed: Upgrade aborted (nd2 failed: Disk full)" {
+		t.Errorf("Firmware.WaitUpgrade() error = %v, expected an upgrade error", err)
+	}
+	expected := []string{
+		"running [nd1 running (40%), nd2 pending]",
+		"running [nd1 completed (100%), nd2 running (10%)]",
+		"failed: Upgrade aborted [nd1 completed (100%), nd2 failed: Disk full]",
+	}
+	if strings.Join(messages, "|") != strings.Join(expected, "|") {
+		t.Errorf("Firmware.WaitUpgrade() progress = %q, expected %q", messages, expected)
+	}
+}
@@ -0,0 +1,59 @@
+package nd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// NotificationsService handles the notifications sent by ND, ie the emails sent with the SMTP configuration of ND.
+type NotificationsService service
+
+// TestEmail is the result of a test email sent by ND.
+type TestEmail struct {
+	Recipient string `json:"recipient"`
+	Success   bool   `json:"success"`
+	// Message is the response of the SMTP server, or the reason of the failure when the email was not sent.
+	Message string `json:"message,omitempty"`
+}
+
+// Err returns a *TestEmailError when the test email was not sent and nil otherwise.
+func (e *TestEmail) Err() error {
+	if !e.Success {
+		return &TestEmailError{TestEmail: e}
+	}
+	return nil
+}
+
+// TestEmailError is returned when ND was unable to send the test email.
+type TestEmailError struct {
+	TestEmail *TestEmail
+}
+
+func (e *TestEmailError) Error() string {
+	message := fmt.Sprintf("the test email to %s was not sent", e.TestEmail.Recipient)
+	if e.TestEmail.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, e.TestEmail.Message)
+	}
+	return message
+}
+
+// SendTestEmail sends a test email to the recipient with the current SMTP configuration of ND and returns the result
+// of the delivery. A *TestEmailError is returned with the result when the SMTP server did not accept the email.
+func (s *NotificationsService) SendTestEmail(ctx context.Context, recipient string) (*TestEmail, error) {
+	if recipient == "" {
+		return nil, errEmptyIdentifier
+	}
+	path, err := s.client.featurePath(ctx, FeatureSMTPConfig, "test")
+	if err != nil {
+		return nil, err
+	}
+	result := &TestEmail{}
+	if err = s.client.send(ctx, http.MethodPost, path, &TestEmail{Recipient: recipient}, result); err != nil {
+		return nil, err
+	}
+	if result.Recipient == "" {
+		result.Recipient = recipient
+	}
+	return result, result.Err()
+}
@@ -0,0 +1,43 @@
+package nd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestNotificationsSendTestEmail(t *testing.T) {
+	var sent TestEmail
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/infra/clusterConfig/smtp/test" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&sent)
+		if sent.Recipient == "noc@example.com" {
+			io.WriteString(w, `{"recipient": "noc@example.com", "success": true, "message": "250 2.0.0 Ok: queued as 4F2A1"}`)
+			return
+		}
+		io.WriteString(w, `{"success": false, "message": "550 5.1.1 Recipient address rejected"}`)
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	result, err := client.Notifications.SendTestEmail(ctx, "noc@example.com")
+	if err != nil || !result.Success || result.Message != "250 2.0.0 Ok: queued as 4F2A1" {
+		t.Errorf("Notifications.SendTestEmail() = %+v, %v, expected the sent email", result, err)
+	}
+
+	result, err = client.Notifications.SendTestEmail(ctx, "unknown@example.com")
+	var testEmailErr *TestEmailError
+	if !errors.As(err, &testEmailErr) || result == nil || err.Error() != "the test email to unknown@example.com was not sent: 550 5.1.1 Recipient address rejected" {
+		t.Errorf("Notifications.SendTestEmail() = %+v, %v, expected a test email error", result, err)
+	}
+
+	if _, err = client.Notifications.SendTestEmail(ctx, ""); !errors.Is(err, errEmptyIdentifier) {
+		t.Errorf("Notifications.SendTestEmail() error = %v, expected an empty identifier error", err)
+	}
+}
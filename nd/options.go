@@ -0,0 +1,154 @@
+package nd
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures a Client created with NewClient.
+type Option func(*Client) error
+
+// WithCredentials sets the username and password used to log in to ND.
+func WithCredentials(username, password string) Option {
+	return func(c *Client) error {
+		c.username = username
+		c.password = password
+		return nil
+	}
+}
+
+// WithAPIKey sets the username and API key used to authenticate the requests instead of logging in to ND.
+// The login domain and password are ignored when an API key is provided.
+func WithAPIKey(username, apiKey string) Option {
+	return func(c *Client) error {
+		if username == "" || apiKey == "" {
+			return fmt.Errorf("username and API key must be provided")
+		}
+		c.username = username
+		c.apiKey = apiKey
+		return nil
+	}
+}
+
+// WithLoginDomain sets the login domain used to log in to ND, ND uses "DefaultAuth" when it is not provided.
+func WithLoginDomain(domain string) Option {
+	return func(c *Client) error {
+		c.domain = domain
+		return nil
+	}
+}
+
+// WithInsecure disables the verification of the ND certificate.
+func WithInsecure(insecure bool) Option {
+	return func(c *Client) error {
+		c.insecure = insecure
+		return nil
+	}
+}
+
+// WithProxy sends the requests through the proxy server, the credentials are optional and in the form of username:password.
+func WithProxy(proxyUrl, proxyCreds string) Option {
+	return func(c *Client) error {
+		pUrl, err := url.Parse(proxyUrl)
+		if err != nil {
+			return fmt.Errorf("invalid proxy url '%s': %w", proxyUrl, err)
+		}
+		c.proxyUrl = pUrl
+		if proxyCreds != "" {
+			c.proxyHeader = http.Header{}
+			c.proxyHeader.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(proxyCreds)))
+		}
+		return nil
+	}
+}
+
+// WithHTTPClient replaces the HTTP client used to send the requests.
+// The TLS and proxy options are ignored when a HTTP client is provided.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return fmt.Errorf("http client cannot be nil")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithMaxRetries sets the number of retries for requests that fail with a connection error or a transient status code.
+func WithMaxRetries(maxRetries int) Option {
+	return func(c *Client) error {
+		if maxRetries < 0 {
+			return fmt.Errorf("max retries cannot be negative")
+		}
+		c.maxRetries = maxRetries
+		return nil
+	}
+}
+
+// WithBackoff sets the minimum delay, maximum delay and growth factor of the exponential backoff between retries.
+func WithBackoff(minDelay, maxDelay time.Duration, factor float64) Option {
+	return func(c *Client) error {
+		if minDelay < 0 || maxDelay < minDelay || factor < 1 {
+			return fmt.Errorf("invalid backoff: min delay %s, max delay %s, factor %v", minDelay, maxDelay, factor)
+		}
+		c.backoffMinDelay = minDelay
+		c.backoffMaxDelay = maxDelay
+		c.backoffDelayFactor = factor
+		return nil
+	}
+}
+
+// WithLogger sets the logger used for the debug messages of the client, nothing is logged by default.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) error {
+		if logger != nil {
+			c.logger = logger
+		}
+		return nil
+	}
+}
+
+// WithSkipLoggingPayload prevents the request and response payloads from being logged.
+func WithSkipLoggingPayload(skip bool) Option {
+	return func(c *Client) error {
+		c.skipLoggingPayload = skip
+		return nil
+	}
+}
+
+// WithPlatformVersion sets the version of the ND platform, ie "3.2.1e", instead of requesting it from ND.
+func WithPlatformVersion(version string) Option {
+	return func(c *Client) error {
+		platformVersion, err := ParseVersion(version)
+		if err != nil {
+			return err
+		}
+		c.platformVersion = platformVersion
+		return nil
+	}
+}
+
+// WithTaskPollInterval sets the delay between two requests for the status of a task, defaults to 5 seconds.
+func WithTaskPollInterval(interval time.Duration) Option {
+	return func(c *Client) error {
+		if interval <= 0 {
+			return fmt.Errorf("task poll interval must be positive")
+		}
+		c.taskPollInterval = interval
+		return nil
+	}
+}
+
+// WithUploadChunkSize sets the size in bytes of the chunks of a firmware image upload, defaults to 16 MiB.
+func WithUploadChunkSize(size int64) Option {
+	return func(c *Client) error {
+		if size <= 0 {
+			return fmt.Errorf("upload chunk size must be positive")
+		}
+		c.uploadChunkSize = size
+		return nil
+	}
+}
@@ -0,0 +1,218 @@
+package nd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// The states of an ND service.
+const (
+	ServiceStateInstalling = "installing"
+	ServiceStateUpgrading  = "upgrading"
+	ServiceStateEnabling   = "enabling"
+	ServiceStateDisabling  = "disabling"
+	ServiceStateEnabled    = "enabled"
+	ServiceStateDisabled   = "disabled"
+	ServiceStateFailed     = "failed"
+)
+
+// ServiceHealthHealthy is the health of an enabled service whose components are all running.
+const ServiceHealthHealthy = "healthy"
+
+// ServicesService handles the services installed on ND, ie Orchestrator, Insights and Fabric Controller.
+type ServicesService service
+
+// Service is a service installed on ND.
+type Service struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	// Image is the name of an uploaded service image, the service is installed from the catalog of ND when it is empty.
+	Image   string `json:"image,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+	Profile string `json:"deploymentProfile,omitempty"`
+	State   string `json:"state,omitempty"`
+	Health  string `json:"health,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ServiceImage is a service image uploaded to ND.
+type ServiceImage struct {
+	Name    string `json:"name"`
+	Service string `json:"service,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+type serviceList struct {
+	Items []Service `json:"items"`
+}
+
+// Done reports whether the service reached a stable state, enabled and healthy, disabled or failed.
+func (s *Service) Done() bool {
+	switch strings.ToLower(s.State) {
+	case ServiceStateEnabled:
+		return strings.ToLower(s.Health) == ServiceHealthHealthy
+	case ServiceStateDisabled, ServiceStateFailed:
+		return true
+	}
+	return false
+}
+
+// Err returns a *ServiceError when the service failed and nil otherwise.
+func (s *Service) Err() error {
+	if strings.ToLower(s.State) == ServiceStateFailed {
+		return &ServiceError{Service: s}
+	}
+	return nil
+}
+
+// String returns the state of the service, ie "enabled (degraded): 2 of 3 pods running".
+func (s *Service) String() string {
+	status := s.State
+	if s.Health != "" {
+		status = fmt.Sprintf("%s (%s)", status, s.Health)
+	}
+	if s.Message != "" {
+		status = fmt.Sprintf("%s: %s", status, s.Message)
+	}
+	return status
+}
+
+// ServiceError is returned when the installation, upgrade or update of a service failed.
+type ServiceError struct {
+	Service *Service
+}
+
+func (e *ServiceError) Error() string {
+	message := fmt.Sprintf("the service %s %s failed", e.Service.Name, e.Service.Version)
+	if e.Service.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, e.Service.Message)
+	}
+	return message
+}
+
+// List returns the services installed on ND.
+func (s *ServicesService) List(ctx context.Context) ([]Service, error) {
+	list := &serviceList{}
+	path, err := s.client.featurePath(ctx, FeatureServices)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// Get returns the installed service with the name.
+func (s *ServicesService) Get(ctx context.Context, name string) (*Service, error) {
+	if name == "" {
+		return nil, errEmptyIdentifier
+	}
+	service := &Service{}
+	path, err := s.client.featurePath(ctx, FeatureServices, name)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, service); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// Install starts the installation of the service and returns the task of the installation.
+// The ID of the task is empty when ND does not return a task for the installation.
+func (s *ServicesService) Install(ctx context.Context, service *Service) (*Task, error) {
+	path, err := s.client.featurePath(ctx, FeatureServices)
+	if err != nil {
+		return nil, err
+	}
+	task := &Task{}
+	if err = s.client.send(ctx, http.MethodPost, path, service, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// Update starts the update of the installed service and returns the task of the update, the service is upgraded when
+// its version or image changes. The ID of the task is empty when ND does not return a task for the update.
+func (s *ServicesService) Update(ctx context.Context, service *Service) (*Task, error) {
+	if service.Name == "" {
+		return nil, errEmptyIdentifier
+	}
+	path, err := s.client.featurePath(ctx, FeatureServices, service.Name)
+	if err != nil {
+		return nil, err
+	}
+	task := &Task{}
+	if err = s.client.send(ctx, http.MethodPut, path, service, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// Uninstall starts the removal of the service with the name and returns the task of the removal.
+// The ID of the task is empty when ND does not return a task for the removal.
+func (s *ServicesService) Uninstall(ctx context.Context, name string) (*Task, error) {
+	if name == "" {
+		return nil, errEmptyIdentifier
+	}
+	path, err := s.client.featurePath(ctx, FeatureServices, name)
+	if err != nil {
+		return nil, err
+	}
+	task := &Task{}
+	if err = s.client.send(ctx, http.MethodDelete, path, nil, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// UploadImage uploads the service image as a multipart form, the image is streamed from the source and the progress
+// function, when not nil, is called while the image is sent.
+func (s *ServicesService) UploadImage(ctx context.Context, source UploadSource, progress ProgressFunc) (*ServiceImage, error) {
+	path, err := s.client.featurePath(ctx, FeatureServiceImages)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewMultipartRequest(ctx, http.MethodPost, path, nil, "file", source, progress)
+	if err != nil {
+		return nil, err
+	}
+	image := &ServiceImage{}
+	if _, err = s.client.Do(req, image); err != nil {
+		return nil, err
+	}
+	return image, nil
+}
+
+// Wait polls the service with the name until it is enabled and healthy, disabled or failed, or the context is
+// cancelled. The progress function, when not nil, is called with the first state of the service and every time the
+// state changes. A *ServiceError is returned when the service failed.
+func (s *ServicesService) Wait(ctx context.Context, name string, progress func(*Service)) (*Service, error) {
+	var previous *Service
+	for {
+		service, err := s.Get(ctx, name)
+		if err != nil {
+			return previous, err
+		}
+		if progress != nil && (previous == nil || service.String() != previous.String()) {
+			progress(service)
+		}
+		previous = service
+
+		if service.Done() {
+			return service, service.Err()
+		}
+
+		timer := time.NewTimer(s.client.taskPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return service, fmt.Errorf("waiting for the service %s: %w", name, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package nd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestServices(t *testing.T) {
+	requests := []string{}
+	var sent Service
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/infra/services":
+			io.WriteString(w, `{"items": [{"name": "orchestrator", "version": "4.1.1", "state": "enabled", "health": "healthy"}]}`)
+		case r.Method == "GET" && r.URL.Path == "/api/v1/infra/services/orchestrator":
+			io.WriteString(w, `{"name": "orchestrator", "version": "4.1.1", "enabled": true, "deploymentProfile": "small", "state": "enabled", "health": "healthy"}`)
+		case r.Method == "POST" || r.Method == "PUT" || r.Method == "DELETE":
+			json.NewDecoder(r.Body).Decode(&sent)
+			w.WriteHeader(http.StatusAccepted)
+			io.WriteString(w, `{"id": "task1", "status": "pending"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	services, err := client.Services.List(ctx)
+	if err != nil || len(services) != 1 || services[0].Name != "orchestrator" {
+		t.Errorf("Services.List() = %+v, %v, expected the installed service", services, err)
+	}
+
+	service, err := client.Services.Get(ctx, "orchestrator")
+	if err != nil || service.Version != "4.1.1" || !*service.Enabled || service.Profile != "small" || !service.Done() || service.Err() != nil {
+		t.Errorf("Services.Get() = %+v, %v, expected the enabled service", service, err)
+	}
+	if _, err = client.Services.Get(ctx, "insights"); !IsNotFound(err) {
+		t.Errorf("Services.Get() error = %v, expected a not found error", err)
+	}
+
+	enabled := false
+	task, err := client.Services.Install(ctx, &Service{Name: "insights", Version: "6.5.1", Enabled: &enabled})
+	if err != nil || task.Id != "task1" {
+		t.Errorf("Services.Install() = %+v, %v, expected the task of the installation", task, err)
+	}
+	if sent.Name != "insights" || sent.Enabled == nil || *sent.Enabled {
+		t.Errorf("unexpected installation payload %+v", sent)
+	}
+
+	if _, err = client.Services.Update(ctx, &Service{Name: "orchestrator", Version: "4.1.2"}); err != nil || sent.Version != "4.1.2" {
+		t.Errorf("Services.Update() error = %v, payload %+v, expected the new version", err, sent)
+	}
+	if _, err = client.Services.Uninstall(ctx, "orchestrator"); err != nil {
+		t.Error(err)
+	}
+	if _, err = client.Services.Update(ctx, &Service{}); !errors.Is(err, errEmptyIdentifier) {
+		t.Errorf("Services.Update() error = %v, expected an empty identifier error", err)
+	}
+
+	expected := []string{
+		"GET /api/v1/infra/services",
+		"GET /api/v1/infra/services/orchestrator",
+		"GET /api/v1/infra/services/insights",
+		"POST /api/v1/infra/services",
+		"PUT /api/v1/infra/services/orchestrator",
+		"DELETE /api/v1/infra/services/orchestrator",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("requests = %q, expected %q", requests, expected)
+	}
+}
+
+func TestServicesWait(t *testing.T) {
+	statuses := []string{
+		`{"name": "insights", "state": "installing", "message": "Pulling the images"}`,
+		`{"name": "insights", "state": "installing", "message": "Pulling the images"}`,
+		`{"name": "insights", "state": "enabled", "health": "degraded", "message": "2 of 3 pods running"}`,
+		`{"name": "insights", "state": "enabled", "health": "healthy"}`,
+	}
+	requests := 0
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, statuses[min(requests, len(statuses)-1)])
+		requests++
+	})
+	client := newTestClient(t, server, WithTaskPollInterval(1))
+
+	progress := []string{}
+	service, err := client.Services.Wait(context.Background(), "insights", func(service *Service) {
+		progress = append(progress, service.String())
+	})
+	if err != nil || service.Health != "healthy" {
+		t.Fatalf("Services.Wait() = %+v, %v, expected the healthy service", service, err)
+	}
+	expected := []string{
+		"installing: Pulling the images",
+		"enabled (degraded): 2 of 3 pods running",
+		"enabled (healthy)",
+	}
+	if strings.Join(progress, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("progress = %q, expected %q", progress, expected)
+	}
+
+	statuses = []string{`{"name": "insights", "version": "6.5.1", "state": "failed", "message": "Not enough resources"}`}
+	_, err = client.Services.Wait(context.Background(), "insights", nil)
+	var serviceErr *ServiceError
+	if !errors.As(err, &serviceErr) || err.Error() != "the service insights 6.5.1 failed: Not enough resources" {
+		t.Errorf("Services.Wait() error = %v, expected a service error", err)
+	}
+}
+
+func TestServicesUploadImage(t *testing.T) {
+	var fileName, content string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		data, _ := io.ReadAll(file)
+		fileName, content = header.Filename, string(data)
+		io.WriteString(w, `{"name": "insights-6.5.1.nd", "service": "insights", "version": "6.5.1"}`)
+	})
+	client := newTestClient(t, server)
+
+	image, err := client.Services.UploadImage(context.Background(), NewBytesSource("insights-6.5.1.nd", []byte("image")), nil)
+	if err != nil || image.Name != "insights-6.5.1.nd" || image.Version != "6.5.1" {
+		t.Errorf("Services.UploadImage() = %+v, %v, expected the uploaded image", image, err)
+	}
+	if fileName != "insights-6.5.1.nd" || content != "image" {
+		t.Errorf("received file %s with %q, expected the image", fileName, content)
+	}
+}
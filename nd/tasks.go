@@ -0,0 +1,129 @@
+package nd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultTaskPollInterval is the delay between two requests for the status of a task.
+const DefaultTaskPollInterval time.Duration = 5 * time.Second
+
+// The statuses of an ND task.
+const (
+	TaskStatusPending   = "pending"
+	TaskStatusRunning   = "running"
+	TaskStatusCompleted = "completed"
+	TaskStatusFailed    = "failed"
+	TaskStatusCancelled = "cancelled"
+)
+
+// TasksService handles the asynchronous tasks started by ND for long-running operations.
+type TasksService service
+
+// Task is an asynchronous operation executed by ND, ie a backup or a resync of a cluster.
+type Task struct {
+	Id       string   `json:"id"`
+	Type     string   `json:"type,omitempty"`
+	Status   string   `json:"status,omitempty"`
+	Progress int      `json:"progress,omitempty"`
+	Message  string   `json:"message,omitempty"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// Done reports whether the task is finished, successfully or not.
+func (t *Task) Done() bool {
+	switch strings.ToLower(t.Status) {
+	case TaskStatusCompleted, TaskStatusFailed, TaskStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// Err returns a *TaskError when the task failed or was cancelled and nil otherwise.
+func (t *Task) Err() error {
+	switch strings.ToLower(t.Status) {
+	case TaskStatusFailed, TaskStatusCancelled:
+		return &TaskError{Task: t}
+	}
+	return nil
+}
+
+// String returns the progress of the task, ie "running (40%): Uploading the backup".
+func (t *Task) String() string {
+	status := t.Status
+	if status == "" {
+		status = TaskStatusPending
+	}
+	if t.Progress > 0 {
+		status = fmt.Sprintf("%s (%d%%)", status, t.Progress)
+	}
+	if t.Message != "" {
+		status = fmt.Sprintf("%s: %s", status, t.Message)
+	}
+	return status
+}
+
+// TaskError is returned when a task failed or was cancelled.
+type TaskError struct {
+	Task *Task
+}
+
+func (e *TaskError) Error() string {
+	message := fmt.Sprintf("task %s %s", e.Task.Id, strings.ToLower(e.Task.Status))
+	if e.Task.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, e.Task.Message)
+	}
+	if len(e.Task.Errors) > 0 {
+		message = fmt.Sprintf("%s (%s)", message, strings.Join(e.Task.Errors, "; "))
+	}
+	return message
+}
+
+// Get returns the task with the ID.
+func (s *TasksService) Get(ctx context.Context, id string) (*Task, error) {
+	if id == "" {
+		return nil, errEmptyIdentifier
+	}
+	task := &Task{}
+	path, err := s.client.featurePath(ctx, FeatureTasks, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// Wait polls the status of the task until it is done or the context is cancelled.
+// The progress function, when not nil, is called with the first status of the task and every time the status,
+// progress or message of the task changes. A *TaskError is returned when the task failed or was cancelled, the last
+// known status of the task is returned with the error when the status cannot be requested or the context is cancelled.
+func (s *TasksService) Wait(ctx context.Context, id string, progress func(*Task)) (*Task, error) {
+	var previous *Task
+	for {
+		task, err := s.Get(ctx, id)
+		if err != nil {
+			return previous, err
+		}
+
+		if progress != nil && (previous == nil || task.Status != previous.Status || task.Progress != previous.Progress || task.Message != previous.Message) {
+			progress(task)
+		}
+		previous = task
+
+		if task.Done() {
+			return task, task.Err()
+		}
+
+		timer := time.NewTimer(s.client.taskPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return task, fmt.Errorf("waiting for task %s: %w", id, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
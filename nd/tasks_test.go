@@ -0,0 +1,99 @@
+package nd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const tasksPath = "/api/v1/infra/tasks"
+
+// newTestTaskServer returns a server which returns the statuses of the task "task1" in order, the last status is repeated.
+func newTestTaskServer(t *testing.T, statuses ...Task) *Client {
+	t.Helper()
+	var requests atomic.Int64
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != tasksPath+"/task1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		index := int(requests.Add(1)) - 1
+		if index >= len(statuses) {
+			index = len(statuses) - 1
+		}
+		task := statuses[index]
+		task.Id = "task1"
+		json.NewEncoder(w).Encode(task)
+	})
+	return newTestClient(t, server, WithTaskPollInterval(time.Millisecond))
+}
+
+func TestTasksWait(t *testing.T) {
+	client := newTestTaskServer(t,
+		Task{Status: TaskStatusPending},
+		Task{Status: TaskStatusRunning, Progress: 50, Message: "Collecting the configuration"},
+		Task{Status: TaskStatusRunning, Progress: 50, Message: "Collecting the configuration"},
+		Task{Status: TaskStatusCompleted, Progress: 100},
+	)
+
+	messages := []string{}
+	task, err := client.Tasks.Wait(context.Background(), "task1", func(task *Task) {
+		messages = append(messages, task.String())
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Status != TaskStatusCompleted {
+		t.Errorf("Tasks.Wait() = %+v, expected a completed task", task)
+	}
+	expected := []string{"pending", "running (50%): Collecting the configuration", "completed (100%)"}
+	if strings.Join(messages, "|") != strings.Join(expected, "|") {
+		t.Errorf("Tasks.Wait() progress = %q, expected %q", messages, expected)
+	}
+}
+
+func TestTasksWaitFailed(t *testing.T) {
+	client := newTestTaskServer(t,
+		Task{Status: TaskStatusRunning},
+		Task{Status: TaskStatusFailed, Message: "Backup failed", Errors: []string{"Remote location unreachable"}},
+	)
+
+	_, err := client.Tasks.Wait(context.Background(), "task1", nil)
+	var taskErr *TaskError
+	if !errors.As(err, &taskErr) {
+		t.Fatalf("Tasks.Wait() error = %v, expected a TaskError", err)
+	}
+	if err.Error() != "task task1 failed: Backup failed (Remote location unreachable)" {
+		t.Errorf("Tasks.Wait() error = %q", err)
+	}
+}
+
+func TestTasksWaitContextCancelled(t *testing.T) {
+	client := newTestTaskServer(t, Task{Status: TaskStatusRunning})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	task, err := client.Tasks.Wait(ctx, "task1", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Tasks.Wait() error = %v, expected the context deadline", err)
+	}
+	if task == nil || task.Status != TaskStatusRunning {
+		t.Errorf("Tasks.Wait() = %+v, expected the last status of the task", task)
+	}
+}
+
+func TestTasksGetNotFound(t *testing.T) {
+	client := newTestTaskServer(t, Task{Status: TaskStatusRunning})
+
+	if _, err := client.Tasks.Get(context.Background(), "missing"); !IsNotFound(err) {
+		t.Errorf("Tasks.Get() error = %v, expected a not found error", err)
+	}
+	if _, err := client.Tasks.Get(context.Background(), ""); err == nil {
+		t.Error("Tasks.Get() expected an error for an empty ID")
+	}
+}
@@ -0,0 +1,201 @@
+package nd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// ProgressFunc is called while the body of an upload is sent with the number of bytes sent and the total number of
+// bytes. The number of bytes sent starts again from 0 when the request is retried.
+type ProgressFunc func(sent, total int64)
+
+// UploadSource is the content of an upload. The source is opened once for every attempt of the request, so the
+// content is streamed again from the start when the request is retried instead of being kept in memory.
+type UploadSource interface {
+	// Name returns the file name of the content.
+	Name() string
+	// Size returns the length of the content in bytes.
+	Size() int64
+	// Open returns a new reader of the content from the start.
+	Open() (io.ReadCloser, error)
+}
+
+type fileSource struct {
+	path string
+	size int64
+}
+
+// NewFileSource returns the upload source of the file at the path, the file is opened again for every attempt.
+func NewFileSource(path string) (UploadSource, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%s is a directory", path)
+	}
+	return &fileSource{path: path, size: info.Size()}, nil
+}
+
+func (s *fileSource) Name() string { return filepath.Base(s.path) }
+
+func (s *fileSource) Size() int64 { return s.size }
+
+func (s *fileSource) Open() (io.ReadCloser, error) {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	// The size of the file is verified because the length of the request is sent before the content.
+	if info, err := file.Stat(); err != nil || info.Size() != s.size {
+		file.Close()
+		return nil, fmt.Errorf("the size of %s changed during the upload", s.path)
+	}
+	return file, nil
+}
+
+type sectionSource struct {
+	name    string
+	section *io.SectionReader
+}
+
+// NewSectionSource returns the upload source of length bytes of the reader starting at the offset.
+func NewSectionSource(name string, reader io.ReaderAt, offset, length int64) UploadSource {
+	return &sectionSource{name: name, section: io.NewSectionReader(reader, offset, length)}
+}
+
+func (s *sectionSource) Name() string { return s.name }
+
+func (s *sectionSource) Size() int64 { return s.section.Size() }
+
+func (s *sectionSource) Open() (io.ReadCloser, error) {
+	return io.NopCloser(io.NewSectionReader(s.section, 0, s.section.Size())), nil
+}
+
+// NewBytesSource returns the upload source of the content in memory.
+func NewBytesSource(name string, content []byte) UploadSource {
+	return NewSectionSource(name, bytes.NewReader(content), 0, int64(len(content)))
+}
+
+// streamRequestKey marks the context of the requests streaming an upload or a download, see requestClient.
+type streamRequestKey struct{}
+
+// NewStreamRequest creates an API request streaming the source as the raw body with the content type, ie
+// "application/octet-stream". The source is opened again when the request is retried and the progress function, when
+// not nil, is called while the body is sent. The duration of the request is only limited by the context.
+func (c *Client) NewStreamRequest(ctx context.Context, method, path, contentType string, source UploadSource, progress ProgressFunc) (*http.Request, error) {
+	size := source.Size()
+	return c.newStreamRequest(ctx, method, path, contentType, size, func() (io.ReadCloser, error) {
+		body, err := source.Open()
+		if err != nil {
+			return nil, err
+		}
+		return newProgressReader(body, size, progress), nil
+	})
+}
+
+// NewMultipartRequest creates an API request sending the fields and the source as a "multipart/form-data" body, the
+// source is sent as the file of the form field with the file field name. The source is streamed and opened again when
+// the request is retried, the progress function, when not nil, is called while the body is sent. The duration of the
+// request is only limited by the context.
+func (c *Client) NewMultipartRequest(ctx context.Context, method, path string, fields map[string]string, fileField string, source UploadSource, progress ProgressFunc) (*http.Request, error) {
+	// The parts before and after the content are built once, so the length of the body is known before it is sent.
+	head := &bytes.Buffer{}
+	writer := multipart.NewWriter(head)
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		if err := writer.WriteField(name, fields[name]); err != nil {
+			return nil, err
+		}
+	}
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, escapeQuotes(fileField), escapeQuotes(source.Name())))
+	header.Set("Content-Type", "application/octet-stream")
+	if _, err := writer.CreatePart(header); err != nil {
+		return nil, err
+	}
+	headLength := head.Len()
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	headBytes, tail := head.Bytes()[:headLength], head.Bytes()[headLength:]
+
+	size := int64(len(headBytes)) + source.Size() + int64(len(tail))
+	return c.newStreamRequest(ctx, method, path, writer.FormDataContentType(), size, func() (io.ReadCloser, error) {
+		content, err := source.Open()
+		if err != nil {
+			return nil, err
+		}
+		body := struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(headBytes), content, bytes.NewReader(tail)), content}
+		return newProgressReader(body, size, progress), nil
+	})
+}
+
+func (c *Client) newStreamRequest(ctx context.Context, method, path, contentType string, size int64, open func() (io.ReadCloser, error)) (*http.Request, error) {
+	fURL, err := c.makeFullUrl(method, path)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := open()
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(context.WithValue(ctx, streamRequestKey{}, true), method, fURL, body)
+	if err != nil {
+		body.Close()
+		return nil, err
+	}
+	req.ContentLength = size
+	req.GetBody = open
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
+
+// isStreamRequest reports whether the request streams an upload or a download.
+func isStreamRequest(req *http.Request) bool {
+	return req.Context().Value(streamRequestKey{}) != nil
+}
+
+// progressReader calls the progress function with the number of bytes read.
+type progressReader struct {
+	io.ReadCloser
+	sent     int64
+	total    int64
+	progress ProgressFunc
+}
+
+func newProgressReader(body io.ReadCloser, total int64, progress ProgressFunc) io.ReadCloser {
+	if progress == nil {
+		return body
+	}
+	return &progressReader{ReadCloser: body, total: total, progress: progress}
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		r.progress(r.sent, r.total)
+	}
+	return n, err
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
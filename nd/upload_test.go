@@ -0,0 +1,134 @@
+package nd
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// countingSource counts the number of times the source is opened.
+type countingSource struct {
+	UploadSource
+	opened int
+}
+
+func (s *countingSource) Open() (io.ReadCloser, error) {
+	s.opened++
+	return s.UploadSource.Open()
+}
+
+func TestNewStreamRequest_Retry(t *testing.T) {
+	received := []string{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = append(received, r.Header.Get("Content-Type")+" "+strconv.FormatInt(r.ContentLength, 10)+" "+string(body))
+		if len(received) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+	client := newTestClient(t, server, WithMaxRetries(1))
+
+	path := filepath.Join(t.TempDir(), "backup.tgz")
+	if err := os.WriteFile(path, []byte("0123456789"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	fileSource, err := NewFileSource(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := &countingSource{UploadSource: fileSource}
+	progress := []int64{}
+	req, err := client.NewStreamRequest(context.Background(), http.MethodPut, "/api/v1/files", "application/octet-stream", source, func(sent, total int64) {
+		if total != 10 {
+			t.Errorf("progress total = %d, expected 10", total)
+		}
+		progress = append(progress, sent)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Do(req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The file is opened again and sent from the start when the request is retried.
+	if source.opened != 2 || len(received) != 2 || received[0] != received[1] || received[1] != "application/octet-stream 10 0123456789" {
+		t.Errorf("source opened %d times, received %q, expected the file twice", source.opened, received)
+	}
+	if len(progress) == 0 || progress[len(progress)-1] != 10 {
+		t.Errorf("progress = %v, expected the progress to end at 10", progress)
+	}
+}
+
+func TestNewMultipartRequest(t *testing.T) {
+	var fields map[string]string
+	var fileName, content string
+	var contentLength, length int64
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body := &bytes.Buffer{}
+		r.Body = io.NopCloser(io.TeeReader(r.Body, body))
+		defer func() {
+			io.Copy(io.Discard, r.Body)
+			contentLength, length = r.ContentLength, int64(body.Len())
+		}()
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Error(err)
+			return
+		}
+		fields = map[string]string{"type": r.FormValue("type"), "name": r.FormValue("name")}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer file.Close()
+		data, _ := io.ReadAll(file)
+		fileName, content = header.Filename, string(data)
+	})
+	client := newTestClient(t, server)
+
+	var sent, total int64
+	req, err := client.NewMultipartRequest(context.Background(), http.MethodPost, "/api/v1/certificates", map[string]string{"type": "pem", "name": "web"}, "file", NewBytesSource(`web "ca".pem`, []byte("certificate")), func(sentBytes, totalBytes int64) {
+		sent, total = sentBytes, totalBytes
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Do(req, nil); err != nil {
+		t.Fatal(err)
+	}
+	if fields["type"] != "pem" || fields["name"] != "web" || fileName != `web "ca".pem` || content != "certificate" {
+		t.Errorf("received fields %v and file %s with %q", fields, fileName, content)
+	}
+	// The length of the body is known before the body is sent.
+	if contentLength != length || sent != length || total != length {
+		t.Errorf("content length %d and progress %d of %d, expected the length of the body %d", contentLength, sent, total, length)
+	}
+}
+
+func TestNewStreamRequest_NoClientTimeout(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		io.Copy(io.Discard, r.Body)
+	})
+	client := newTestClient(t, server)
+	client.httpClient.Timeout = 10 * time.Millisecond
+
+	// The requests are limited by the timeout of the HTTP client, the uploads only by their context.
+	if err := client.send(context.Background(), http.MethodPost, "/api/v1/files", nil, nil); err == nil {
+		t.Error("send() expected a timeout error")
+	}
+	req, err := client.NewStreamRequest(context.Background(), http.MethodPut, "/api/v1/files", "application/octet-stream", NewBytesSource("file", []byte("content")), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Do(req, nil); err != nil {
+		t.Errorf("Do() error = %v, expected the upload not to be limited by the timeout of the HTTP client", err)
+	}
+}
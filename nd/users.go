@@ -0,0 +1,97 @@
+package nd
+
+import (
+	"context"
+	"net/http"
+)
+
+// UsersService handles the local users of ND.
+type UsersService service
+
+// LocalUser is a user authenticated by ND.
+type LocalUser struct {
+	LoginId       string         `json:"loginID"`
+	FirstName     string         `json:"firstName,omitempty"`
+	LastName      string         `json:"lastName,omitempty"`
+	Email         string         `json:"email,omitempty"`
+	Password      string         `json:"password,omitempty"`
+	RemoteIdClaim string         `json:"remoteIDClaim,omitempty"`
+	XLaunch       bool           `json:"xLaunch,omitempty"`
+	Rbac          *LocalUserRbac `json:"rbac,omitempty"`
+}
+
+// LocalUserRbac are the roles of the user in each security domain.
+type LocalUserRbac struct {
+	Domains map[string]LocalUserRbacDomain `json:"domains"`
+}
+
+// LocalUserRbacDomain are the roles of the user in a security domain.
+type LocalUserRbacDomain struct {
+	Roles []string `json:"roles"`
+}
+
+type localUserList struct {
+	Items []LocalUser `json:"items"`
+}
+
+// List returns the local users of ND.
+func (s *UsersService) List(ctx context.Context) ([]LocalUser, error) {
+	list := &localUserList{}
+	path, err := s.client.featurePath(ctx, FeatureLocalUsers)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// Get returns the local user with the login ID.
+func (s *UsersService) Get(ctx context.Context, loginId string) (*LocalUser, error) {
+	if loginId == "" {
+		return nil, errEmptyIdentifier
+	}
+	user := &LocalUser{}
+	path, err := s.client.featurePath(ctx, FeatureLocalUsers, loginId)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.client.get(ctx, path, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Create creates the local user.
+func (s *UsersService) Create(ctx context.Context, user *LocalUser) error {
+	path, err := s.client.featurePath(ctx, FeatureLocalUsers)
+	if err != nil {
+		return err
+	}
+	return s.client.send(ctx, http.MethodPost, path, user, nil)
+}
+
+// Update replaces the configuration of the local user, the password is only changed when it is provided.
+func (s *UsersService) Update(ctx context.Context, user *LocalUser) error {
+	if user.LoginId == "" {
+		return errEmptyIdentifier
+	}
+	path, err := s.client.featurePath(ctx, FeatureLocalUsers, user.LoginId)
+	if err != nil {
+		return err
+	}
+	return s.client.send(ctx, http.MethodPut, path, user, nil)
+}
+
+// Delete deletes the local user with the login ID.
+func (s *UsersService) Delete(ctx context.Context, loginId string) error {
+	if loginId == "" {
+		return errEmptyIdentifier
+	}
+	path, err := s.client.featurePath(ctx, FeatureLocalUsers, loginId)
+	if err != nil {
+		return err
+	}
+	return s.client.send(ctx, http.MethodDelete, path, nil, nil)
+}
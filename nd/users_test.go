@@ -0,0 +1,82 @@
+package nd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+const localUsersPath = "/api/v1/infra/aaa/localUsers"
+
+func TestUsers(t *testing.T) {
+	users := map[string]LocalUser{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		loginId := ""
+		if len(r.URL.Path) > len(localUsersPath) {
+			loginId = r.URL.Path[len(localUsersPath)+1:]
+		}
+		switch r.Method {
+		case "GET":
+			if loginId == "" {
+				list := localUserList{}
+				for _, user := range users {
+					list.Items = append(list.Items, user)
+				}
+				json.NewEncoder(w).Encode(list)
+				return
+			}
+			user, ok := users[loginId]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			// The password is never returned by ND.
+			user.Password = ""
+			json.NewEncoder(w).Encode(user)
+		case "POST", "PUT":
+			var user LocalUser
+			json.NewDecoder(r.Body).Decode(&user)
+			users[user.LoginId] = user
+		case "DELETE":
+			delete(users, loginId)
+		}
+	})
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	user := &LocalUser{
+		LoginId:  "test_user",
+		Email:    "test@example.com",
+		Password: "Secret123!",
+		Rbac:     &LocalUserRbac{Domains: map[string]LocalUserRbacDomain{"all": {Roles: []string{"observer"}}}},
+	}
+	if err := client.Users.Create(ctx, user); err != nil {
+		t.Fatal(err)
+	}
+
+	user.Rbac.Domains["all"] = LocalUserRbacDomain{Roles: []string{"admin"}}
+	if err := client.Users.Update(ctx, user); err != nil {
+		t.Fatal(err)
+	}
+
+	readUser, err := client.Users.Get(ctx, "test_user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if readUser.Email != "test@example.com" || readUser.Password != "" || readUser.Rbac.Domains["all"].Roles[0] != "admin" {
+		t.Errorf("Users.Get() = %+v, expected the updated user", readUser)
+	}
+
+	list, err := client.Users.List(ctx)
+	if err != nil || len(list) != 1 {
+		t.Errorf("Users.List() = %v, %v, expected one user", list, err)
+	}
+
+	if err := client.Users.Delete(ctx, "test_user"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Users.Get(ctx, "test_user"); !IsNotFound(err) {
+		t.Errorf("Users.Get() error = %v, expected a not found error", err)
+	}
+}
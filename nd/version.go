@@ -0,0 +1,98 @@
+package nd
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const versionPath = "/version.json"
+
+// VersionService handles the version information of the ND platform.
+type VersionService service
+
+// Version is the version information of the ND platform.
+type Version struct {
+	CommitId    string `json:"commit_id"`
+	BuildTime   string `json:"build_time"`
+	BuildHost   string `json:"build_host"`
+	User        string `json:"user"`
+	ProductId   string `json:"product_id"`
+	ProductName string `json:"product_name"`
+	Release     bool   `json:"release"`
+	Major       int    `json:"major"`
+	Minor       int    `json:"minor"`
+	Maintenance int    `json:"maintenance"`
+	Patch       string `json:"patch"`
+}
+
+// String returns the version in the format used by ND, ie "3.2.1e".
+func (v *Version) String() string {
+	return fmt.Sprintf("%d.%d.%d%s", v.Major, v.Minor, v.Maintenance, v.Patch)
+}
+
+var versionRegex = regexp.MustCompile(`^(\d+)(?:\.(\d+)(?:\.(\d+)([a-z]*))?)?$`)
+
+// ParseVersion parses a ND version in the format "major.minor.maintenance" followed by an optional patch letter,
+// ie "3.2.1e". The minor and maintenance numbers are optional and default to 0, ie "4.1" is parsed as "4.1.0".
+func ParseVersion(version string) (*Version, error) {
+	matches := versionRegex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(version)))
+	if matches == nil {
+		return nil, fmt.Errorf("invalid ND version '%s', the version must be in the format 'major.minor.maintenance' followed by an optional patch letter", version)
+	}
+
+	numbers := make([]int, 3)
+	for index, match := range matches[1:4] {
+		if match == "" {
+			continue
+		}
+		number, err := strconv.Atoi(match)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ND version '%s': %w", version, err)
+		}
+		numbers[index] = number
+	}
+	return &Version{Major: numbers[0], Minor: numbers[1], Maintenance: numbers[2], Patch: matches[4]}, nil
+}
+
+// MustParseVersion is like ParseVersion but panics when the version is invalid.
+func MustParseVersion(version string) *Version {
+	v, err := ParseVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Compare returns -1, 0 or 1 when the version is lower, equal or higher than the other version.
+// The patch letters are compared after the numbers, a version without a patch letter is lower than the same version
+// with a patch letter, ie "3.2.1" < "3.2.1a" < "3.2.1e" < "3.2.2".
+func (v *Version) Compare(other *Version) int {
+	for _, numbers := range [][2]int{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Maintenance, other.Maintenance}} {
+		if numbers[0] != numbers[1] {
+			if numbers[0] < numbers[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	// Longer patch letters sort after shorter ones, ie "z" < "aa".
+	if len(v.Patch) != len(other.Patch) {
+		if len(v.Patch) < len(other.Patch) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(v.Patch, other.Patch)
+}
+
+// Get returns the version of the ND platform.
+func (s *VersionService) Get(ctx context.Context) (*Version, error) {
+	version := &Version{}
+	if err := s.client.get(ctx, versionPath, version); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
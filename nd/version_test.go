@@ -0,0 +1,75 @@
+package nd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestVersionGet(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"commit_id": "abc", "product_name": "Nexus Dashboard", "release": true, "major": 3, "minor": 2, "maintenance": 1, "patch": "e"}`)
+	})
+	client := newTestClient(t, server)
+
+	version, err := client.Version.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version.CommitId != "abc" || version.ProductName != "Nexus Dashboard" || !version.Release {
+		t.Errorf("Version.Get() = %+v, expected the version.json attributes", version)
+	}
+	if version.String() != "3.2.1e" {
+		t.Errorf("Version.String() = %s, expected 3.2.1e", version.String())
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := map[string]string{
+		"3.2.1e":  "3.2.1e",
+		"3.2.1":   "3.2.1",
+		"4.1":     "4.1.0",
+		"4":       "4.0.0",
+		" 3.1.1K": "3.1.1k",
+		"2.3.2aa": "2.3.2aa",
+	}
+	for input, expected := range tests {
+		version, err := ParseVersion(input)
+		if err != nil || version.String() != expected {
+			t.Errorf("ParseVersion(%q) = %v, %v, expected %s", input, version, err, expected)
+		}
+	}
+
+	for _, input := range []string{"", "v3.2.1", "3.2.1.1", "3.2e", "3.x", "3.2.1-e"} {
+		if version, err := ParseVersion(input); err == nil {
+			t.Errorf("ParseVersion(%q) = %v, expected an error", input, version)
+		}
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	// The versions are sorted from the lowest to the highest.
+	versions := []string{"2.3.2d", "3.0.1i", "3.1", "3.1.1", "3.1.1a", "3.1.1k", "3.1.1z", "3.1.1aa", "3.1.2", "3.2.1e", "3.2.2m", "4.1.1g", "10.0.0"}
+	for i, a := range versions {
+		for j, b := range versions {
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			if actual := MustParseVersion(a).Compare(MustParseVersion(b)); actual != expected {
+				t.Errorf("Compare(%s, %s) = %d, expected %d", a, b, actual, expected)
+			}
+		}
+	}
+
+	if MustParseVersion("4.1").Compare(MustParseVersion("4.1.0")) != 0 {
+		t.Errorf("Compare(4.1, 4.1.0) expected the versions to be equal")
+	}
+}